@@ -0,0 +1,43 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+)
+
+// And returns a validation rule that checks if a value passes all the given rules. The rules are applied in order
+// and the error of the first rule that fails is returned, just like a list of rules given to Validate or Field.
+// And is useful to group rules into a single one, typically as an alternative of Or:
+//
+//	valid.Or(is.EmailFormat, valid.And(is.Digit, valid.Length(10, 15)))
+//
+// Unlike Validate, And only applies the rules, so a validatable value is not validated by its own Validate() method.
+func And(rules ...Rule) AndRule {
+	return AndRule{rules: rules}
+}
+
+// AndRule is a validation rule that checks if a value passes all the specified rules.
+type AndRule struct {
+	rules []Rule
+}
+
+// Validate checks if the given value passes all the rules.
+func (r AndRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext checks if the given value passes all the rules using the given context.
+func (r AndRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	for _, rule := range r.rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return nil
+		}
+		if err := applyRule(ctx, rule, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
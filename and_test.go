@@ -0,0 +1,47 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnd(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  AndRule
+		value interface{}
+		err   string
+	}{
+		{"t1", And(), "abc", ""},
+		{"t2", And(Required, Length(2, 3)), "abc", ""},
+		{"t3", And(Required, Length(2, 3)), "", "cannot be blank"},
+		{"t4", And(Required, Length(2, 3)), "abcd", "the length must be between 2 and 3"},
+		{"t5", And(Length(2, 3), In("ab")), "abc", "must be a valid value"},
+		{"t6", And(Skip, Required), "", ""},
+		{"t7", And(Required, Skip.When(false), In("x")), "a", "must be a valid value"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// a validatable value is only checked by the rules
+	assert.Nil(t, And(Required).Validate(String123("xyz")))
+	assertError(t, "error 123", Validate(String123("xyz"), And(Required)), "t8")
+
+	ctxRule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx == nil {
+			return errors.New("no context")
+		}
+		return nil
+	})
+	assert.Nil(t, And(Required, ctxRule).ValidateWithContext(context.Background(), "a"))
+}
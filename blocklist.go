@@ -0,0 +1,80 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ErrBlocklist is the error that returns in case of a value containing blocked content.
+// The message does not include the blocked content so that it is not echoed back to the user.
+var ErrBlocklist = NewError("validation_blocklist", "contains disallowed content")
+
+// Blocklist returns a validation rule that checks if a string does not contain any of the given words or phrases,
+// e.g. for basic content moderation. The words are matched case-insensitively and only as whole words,
+// so Blocklist([]string{"ass"}) rejects "You ASS!" but accepts "class" and "assassin".
+// Empty words are ignored.
+// This rule should only be used for validating strings and byte slices, or a validation error will be reported.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Blocklist(words []string) BlocklistRule {
+	var patterns []string
+	for _, w := range words {
+		if w != "" {
+			patterns = append(patterns, regexp.QuoteMeta(w))
+		}
+	}
+	if len(patterns) == 0 {
+		return BlocklistFunc(func(string) bool { return false })
+	}
+	// a word boundary is the beginning or the end of the value, or a character other than a letter, digit or underscore
+	re := regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])(?:` + strings.Join(patterns, "|") + `)(?:$|[^\p{L}\p{N}_])`)
+	return BlocklistFunc(re.MatchString)
+}
+
+// BlocklistFunc returns a validation rule that checks a string with a custom matcher, which returns true
+// if the string contains disallowed content. Please refer to Blocklist for more details.
+func BlocklistFunc(matcher func(string) bool) BlocklistRule {
+	return BlocklistRule{
+		matcher: matcher,
+		err:     ErrBlocklist,
+	}
+}
+
+// BlocklistRule is a validation rule that checks if a string does not contain disallowed content.
+type BlocklistRule struct {
+	matcher func(string) bool
+	err     Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r BlocklistRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.matcher(str) {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r BlocklistRule) Error(message string) BlocklistRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r BlocklistRule) ErrorObject(err Error) BlocklistRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,61 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocklist(t *testing.T) {
+	var empty *string
+	text := "Buy CHEAP pills"
+	tests := []struct {
+		tag   string
+		words []string
+		value interface{}
+		err   string
+	}{
+		{"t1", []string{"ass", "cheap pills"}, "", ""},
+		{"t2", []string{"ass", "cheap pills"}, empty, ""},
+		{"t3", []string{"ass", "cheap pills"}, "You ASS!", "contains disallowed content"},
+		{"t4", []string{"ass", "cheap pills"}, "ass", "contains disallowed content"},
+		{"t5", []string{"ass", "cheap pills"}, "a class on the assassin", ""},
+		{"t6", []string{"ass", "cheap pills"}, "pass_ass", ""},
+		{"t7", []string{"ass", "cheap pills"}, &text, "contains disallowed content"},
+		{"t8", []string{"ass", "cheap pills"}, []byte("ass-kicking"), "contains disallowed content"},
+		{"t9", []string{"ass", "cheap pills"}, "cheap pillsbury", ""},
+		{"t10", []string{"Öl"}, "kein öl hier", "contains disallowed content"},
+		{"t11", []string{"Öl"}, "Ölsardine", ""},
+		{"t12", []string{"a.b"}, "axb", ""},
+		{"t13", []string{"a.b"}, "see a.b", "contains disallowed content"},
+		{"t14", []string{""}, "anything", ""},
+		{"t15", nil, "anything", ""},
+		{"t16", []string{"ass"}, 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := Blocklist(test.words).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestBlocklistFunc(t *testing.T) {
+	r := BlocklistFunc(func(s string) bool { return strings.Contains(s, "spam") })
+	assert.Nil(t, r.Validate("ham"))
+	assert.Nil(t, r.Validate(""))
+	assertError(t, "contains disallowed content", r.Validate("spammer"), "t1")
+	assertError(t, "be nice", r.Error("be nice").Validate("spam"), "t2")
+}
+
+func TestBlocklistRule_ErrorObject(t *testing.T) {
+	r := Blocklist([]string{"spam"})
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assertError(t, "abc", r.Validate("spam"), "t1")
+}
@@ -0,0 +1,163 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrMaxBytes is the error that returns when a value is larger than the allowed number of bytes.
+	ErrMaxBytes = NewError("validation_max_bytes", "the size must be no more than {{.max}} bytes")
+	// ErrByteSizeInvalid is the error that returns when a value is not a valid byte size.
+	ErrByteSizeInvalid = NewError("validation_byte_size_invalid", "must be a valid byte size (e.g. 10MB)")
+	// ErrByteSizeTooLarge is the error that returns when a byte size is larger than the allowed maximum.
+	ErrByteSizeTooLarge = NewError("validation_byte_size_too_large", "must be no more than {{.max}} bytes")
+)
+
+var reByteSize = regexp.MustCompile(`(?i)^([0-9]+(?:\.[0-9]+)?)(?: ?(?:([kmgtpe]i?)b?|b))?$`)
+
+// byteUnits maps the lower-cased unit prefixes to their exponents.
+var byteUnits = map[string]float64{"": 0, "k": 1, "m": 2, "g": 3, "t": 4, "p": 5, "e": 6}
+
+// MaxBytes returns a validation rule that checks if the byte length of a string or byte slice is no more than n.
+// Unlike Length and RuneLength, which count characters, MaxBytes counts the bytes of the value as it is stored or
+// transferred, which makes it suitable for checking payloads against a size limit.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxBytes(n int) MaxBytesRule {
+	return MaxBytesRule{
+		max: n,
+		err: ErrMaxBytes.SetParams(map[string]interface{}{"max": n}),
+	}
+}
+
+// MaxBytesRule is a validation rule that checks if the byte length of a value is within the limit.
+type MaxBytesRule struct {
+	max int
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r MaxBytesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+	if len(str) > r.max {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r MaxBytesRule) Error(message string) MaxBytesRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MaxBytesRule) ErrorObject(err Error) MaxBytesRule {
+	r.err = err
+	return r
+}
+
+// ByteSizeString returns a validation rule that checks if a string is a human-readable byte size, such as "512",
+// "10MB", "1.5 GiB" or "64k". The units are case-insensitive; the decimal units (KB, MB, ...) are powers of 1000
+// and the binary units (KiB, MiB, ...) are powers of 1024. A number without a unit is a number of bytes.
+// Call Max() to also limit the size that the string represents.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ByteSizeString() ByteSizeRule {
+	return ByteSizeRule{
+		max:    -1,
+		err:    ErrByteSizeInvalid,
+		maxErr: ErrByteSizeTooLarge,
+	}
+}
+
+// ByteSizeRule is a validation rule that checks if a string is a valid human-readable byte size.
+type ByteSizeRule struct {
+	max    int64
+	err    Error
+	maxErr Error
+}
+
+// Max configures the rule to only accept sizes of no more than the given number of bytes.
+func (r ByteSizeRule) Max(n int64) ByteSizeRule {
+	r.max = n
+	r.maxErr = r.maxErr.SetParams(map[string]interface{}{"max": n})
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ByteSizeRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+	size, ok := parseByteSize(str)
+	if !ok {
+		return r.err
+	}
+	if r.max >= 0 && size > float64(r.max) {
+		return r.maxErr
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r ByteSizeRule) Error(message string) ByteSizeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ByteSizeRule) ErrorObject(err Error) ByteSizeRule {
+	r.err = err
+	return r
+}
+
+// MaxError sets the error message returned when the size is larger than the maximum.
+func (r ByteSizeRule) MaxError(message string) ByteSizeRule {
+	r.maxErr = r.maxErr.SetMessage(message)
+	return r
+}
+
+// MaxErrorObject sets the error struct returned when the size is larger than the maximum.
+func (r ByteSizeRule) MaxErrorObject(err Error) ByteSizeRule {
+	r.maxErr = err
+	return r
+}
+
+// parseByteSize parses a human-readable byte size and returns the number of bytes it represents.
+func parseByteSize(str string) (float64, bool) {
+	m := reByteSize.FindStringSubmatch(str)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	unit := strings.ToLower(m[2])
+	base := 1000.0
+	if strings.HasSuffix(unit, "i") {
+		base = 1024
+		unit = strings.TrimSuffix(unit, "i")
+	}
+	return n * math.Pow(base, byteUnits[unit]), true
+}
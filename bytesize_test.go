@@ -0,0 +1,102 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxBytes(t *testing.T) {
+	var v *string
+	tests := []struct {
+		tag   string
+		max   int
+		value interface{}
+		err   string
+	}{
+		{"t1", 3, "abc", ""},
+		{"t2", 3, "abcd", "the size must be no more than 3 bytes"},
+		{"t3", 3, "äb", ""},
+		{"t4", 3, "äbc", "the size must be no more than 3 bytes"},
+		{"t5", 3, []byte("abc"), ""},
+		{"t6", 3, []byte("abcd"), "the size must be no more than 3 bytes"},
+		{"t7", 3, "", ""},
+		{"t8", 3, v, ""},
+		{"t9", 3, 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := MaxBytes(test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, MaxBytes(1).Error("too big").Validate("ab"), "too big")
+	err := NewError("code", "abc")
+	assert.Equal(t, err, MaxBytes(1).ErrorObject(err).err)
+}
+
+func TestByteSizeString(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  ByteSizeRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", ByteSizeString(), "", ""},
+		{"t1.2", ByteSizeString(), "512", ""},
+		{"t1.3", ByteSizeString(), "10MB", ""},
+		{"t1.4", ByteSizeString(), "1.5 GiB", ""},
+		{"t1.5", ByteSizeString(), "64k", ""},
+		{"t1.6", ByteSizeString(), "100b", ""},
+		{"t1.7", ByteSizeString(), []byte("1tb"), ""},
+		{"t1.8", ByteSizeString(), "10XB", "must be a valid byte size (e.g. 10MB)"},
+		{"t1.9", ByteSizeString(), "MB", "must be a valid byte size (e.g. 10MB)"},
+		{"t1.10", ByteSizeString(), "-1MB", "must be a valid byte size (e.g. 10MB)"},
+		{"t1.11", ByteSizeString(), "10 ", "must be a valid byte size (e.g. 10MB)"},
+		{"t1.12", ByteSizeString(), "1.MB", "must be a valid byte size (e.g. 10MB)"},
+		{"t1.13", ByteSizeString(), 10, "must be either a string or byte slice"},
+		{"t2.1", ByteSizeString().Max(1024), "1KiB", ""},
+		{"t2.2", ByteSizeString().Max(1024), "1.1KiB", "must be no more than 1024 bytes"},
+		{"t2.3", ByteSizeString().Max(1000), "1KB", ""},
+		{"t2.4", ByteSizeString().Max(1000), "1KiB", "must be no more than 1000 bytes"},
+		{"t2.5", ByteSizeString().Max(1000), "1XB", "must be a valid byte size (e.g. 10MB)"},
+		{"t2.6", ByteSizeString().Max(0), "0", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := ByteSizeString().Max(1).Error("bad size").MaxError("too big")
+	assert.EqualError(t, r.Validate("x"), "bad size")
+	assert.EqualError(t, r.Validate("2"), "too big")
+	err := NewError("code", "abc")
+	assert.Equal(t, err, r.ErrorObject(err).err)
+	assert.Equal(t, err, r.MaxErrorObject(err).maxErr)
+}
+
+func Test_parseByteSize(t *testing.T) {
+	tests := []struct {
+		value string
+		size  float64
+	}{
+		{"1", 1},
+		{"1B", 1},
+		{"1k", 1000},
+		{"1KB", 1000},
+		{"1Ki", 1024},
+		{"1KiB", 1024},
+		{"2.5 MB", 2500000},
+		{"1GiB", 1 << 30},
+	}
+	for _, test := range tests {
+		size, ok := parseByteSize(test.value)
+		assert.True(t, ok, test.value)
+		assert.Equal(t, test.size, size, test.value)
+	}
+}
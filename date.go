@@ -33,11 +33,14 @@ type DateRule struct {
 // By calling Min() and/or Max(), you can let the Date rule to check if a parsed date value is within
 // the specified date range.
 //
+// The error of an invalid date has the "layout" parameter, and the error of a date out of range has
+// the "min" and/or "max" parameters for the range set by Min() and Max().
+//
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func Date(layout string) DateRule {
 	return DateRule{
 		layout:   layout,
-		err:      ErrDateInvalid,
+		err:      ErrDateInvalid.SetParams(map[string]interface{}{"layout": layout}),
 		rangeErr: ErrDateOutOfRange,
 	}
 }
@@ -96,8 +99,20 @@ func (r DateRule) Validate(value interface{}) error {
 	}
 
 	if !r.min.IsZero() && r.min.After(date) || !r.max.IsZero() && date.After(r.max) {
-		return r.rangeErr
+		return r.rangeErr.SetParams(r.rangeParams())
 	}
 
 	return nil
 }
+
+// rangeParams returns the parameters of the range error, which only include the bounds that are set.
+func (r DateRule) rangeParams() map[string]interface{} {
+	params := map[string]interface{}{}
+	if !r.min.IsZero() {
+		params["min"] = r.min
+	}
+	if !r.max.IsZero() {
+		params["max"] = r.max
+	}
+	return params
+}
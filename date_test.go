@@ -89,3 +89,23 @@ func TestDateRule_MinMax(t *testing.T) {
 		assert.Equal(t, "the date is out of range", err.Error())
 	}
 }
+
+func TestDate_Params(t *testing.T) {
+	err := Date("2006-01-02").Validate("abc")
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"layout": "2006-01-02"}, err.(Error).Params())
+		assert.Equal(t, "must be a valid date", err.Error())
+	}
+
+	min := time.Date(2000, 12, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	err = Date("2006-01-02").Min(min).Validate("1999-01-02")
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"min": min}, err.(Error).Params())
+		assert.Equal(t, "the date is out of range", err.Error())
+	}
+	err = Date("2006-01-02").Min(min).Max(max).Validate("2021-01-02")
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"min": min, "max": max}, err.(Error).Params())
+	}
+}
@@ -11,9 +11,28 @@ import (
 	"strconv"
 )
 
-// Each returns a validation rule that loops through an iterable (map, slice or array)
+// eachIndexKey is the context key under which EachRule stores the index or key of the element being validated.
+type eachIndexKey struct{}
+
+// IndexFromContext returns the index (for slices and arrays) or the key (for maps) of the element
+// currently being validated by an Each rule.
+// It is only available when the validation is performed with a context (e.g. via ValidateWithContext).
+// If the element is nested within multiple Each rules, the index of the innermost element is returned.
+// The boolean result is false if no index is found in the context.
+func IndexFromContext(ctx context.Context) (interface{}, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	index := ctx.Value(eachIndexKey{})
+	return index, index != nil
+}
+
+// Each returns a validation rule that loops through an iterable (map, slice, array or string)
 // and validates each value inside with the provided rules.
+// A string is iterated by runes (Unicode code points) rather than bytes: each rune is validated as a single-rune
+// string, and its errors are keyed by the position of the rune (not the byte offset) in the string.
 // An empty iterable is considered valid. Use the Required rule to make sure the iterable is not empty.
+// A pointer to an iterable is dereferenced first, and a nil pointer is considered valid.
 func Each(rules ...Rule) EachRule {
 	return EachRule{
 		rules: rules,
@@ -22,7 +41,35 @@ func Each(rules ...Rule) EachRule {
 
 // EachRule is a validation rule that validates elements in a map/slice/array using the specified list of rules.
 type EachRule struct {
-	rules []Rule
+	rules     []Rule
+	keyRules  []Rule
+	thenRules []Rule
+	keepKeys  bool
+}
+
+// Keys configures the rule to also validate the keys of a map with the given rules. A key is validated before its
+// value, and the value is not validated if the key is invalid, so that the error reported for an entry is that of
+// its key. Like the errors of the values, the errors of the keys are keyed by the keys formatted with "%v", which
+// uses the String method of a custom key type if it has one. The key rules are ignored when validating
+// a slice, array or string.
+//
+//	valid.Field(&c.Prices, valid.Each(valid.Min(0)).Keys(valid.In(USD, EUR)))
+func (r EachRule) Keys(rules ...Rule) EachRule {
+	r.keyRules = rules
+	return r
+}
+
+// Then configures the rule to validate the whole iterable with the given rules after all its elements are validated,
+// so that a field can declare the constraints of both its elements and the collection at once:
+//
+//	valid.Field(&p.Tags, valid.Each(valid.Required, valid.Length(1, 20)).Then(valid.Length(0, 10), valid.Unique()))
+//
+// Like the rules of a field, the rules given to Then are only executed if the elements are valid, and the error of
+// the first failing rule is returned. To check the collection before its elements, put the rules before Each instead,
+// e.g. valid.Field(&p.Tags, valid.Length(0, 10), valid.Each(valid.Required)).
+func (r EachRule) Then(rules ...Rule) EachRule {
+	r.thenRules = rules
+	return r
 }
 
 // Validate loops through the given iterable and calls the Ozzo Validate() method for each value.
@@ -32,21 +79,51 @@ func (r EachRule) Validate(value interface{}) error {
 
 // ValidateWithContext loops through the given iterable and calls the Ozzo ValidateWithContext() method for each value.
 func (r EachRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if err := r.validateElements(ctx, value); err != nil || len(r.thenRules) == 0 {
+		return err
+	}
+	if ctx == nil {
+		return Validate(value, r.thenRules...)
+	}
+	return ValidateWithContext(ctx, value, r.thenRules...)
+}
+
+// validateElements validates each element of the given iterable. If ctx is nil, the elements are validated without a context.
+func (r EachRule) validateElements(ctx context.Context, value interface{}) error {
 	errs := Errors{}
+	var keys map[string]interface{}
 
 	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
 	switch v.Kind() {
 	case reflect.Map:
 		for _, k := range v.MapKeys() {
 			val := r.getInterface(v.MapIndex(k))
 			var err error
 			if ctx == nil {
-				err = Validate(val, r.rules...)
+				if err = r.validateKey(nil, k); err == nil {
+					err = Validate(val, r.rules...)
+				}
 			} else {
-				err = ValidateWithContext(ctx, val, r.rules...)
+				kctx := context.WithValue(ctx, eachIndexKey{}, k.Interface())
+				if err = r.validateKey(kctx, k); err == nil {
+					err = ValidateWithContext(kctx, val, r.rules...)
+				}
 			}
 			if err != nil {
-				errs[r.getString(k)] = err
+				name := r.getString(k)
+				errs[name] = err
+				if r.keepKeys {
+					if keys == nil {
+						keys = map[string]interface{}{}
+					}
+					keys[name] = k.Interface()
+				}
 			}
 		}
 	case reflect.Slice, reflect.Array:
@@ -56,20 +133,62 @@ func (r EachRule) ValidateWithContext(ctx context.Context, value interface{}) er
 			if ctx == nil {
 				err = Validate(val, r.rules...)
 			} else {
-				err = ValidateWithContext(ctx, val, r.rules...)
+				err = ValidateWithContext(context.WithValue(ctx, eachIndexKey{}, i), val, r.rules...)
+			}
+			if err != nil {
+				errs[strconv.Itoa(i)] = err
+			}
+		}
+	case reflect.String:
+		for i, c := range []rune(v.String()) {
+			var err error
+			if ctx == nil {
+				err = Validate(string(c), r.rules...)
+			} else {
+				err = ValidateWithContext(context.WithValue(ctx, eachIndexKey{}, i), string(c), r.rules...)
 			}
 			if err != nil {
 				errs[strconv.Itoa(i)] = err
 			}
 		}
 	default:
-		return errors.New("must be an iterable (map, slice or array)")
+		return errors.New("must be an iterable (map, slice, array or string)")
 	}
 
-	if len(errs) > 0 {
-		return errs
+	if len(errs) == 0 {
+		return nil
+	}
+	if keys != nil {
+		return KeyedErrors{Errors: errs, keys: keys}
+	}
+	return errs
+}
+
+// KeepKeys configures the rule to return the errors of a map as KeyedErrors, which retain the original map keys
+// (e.g. the int keys of a map[int]T) in addition to the keys formatted with "%v" used by Errors. This lets the
+// callers recover the map entries that failed:
+//
+//	if errs, ok := err.(valid.KeyedErrors); ok {
+//	    for _, e := range errs.Keyed() {
+//	        fmt.Println(e.Key.(int), e.Err)
+//	    }
+//	}
+//
+// The errors of a slice, array or string are still returned as Errors.
+func (r EachRule) KeepKeys() EachRule {
+	r.keepKeys = true
+	return r
+}
+
+// validateKey validates a map key with the key rules. If ctx is nil, the key is validated without a context.
+func (r EachRule) validateKey(ctx context.Context, key reflect.Value) error {
+	if len(r.keyRules) == 0 {
+		return nil
+	}
+	if ctx == nil {
+		return Validate(r.getInterface(key), r.keyRules...)
 	}
-	return nil
+	return ValidateWithContext(ctx, r.getInterface(key), r.keyRules...)
 }
 
 func (r EachRule) getInterface(value reflect.Value) interface{} {
@@ -90,8 +209,8 @@ func (r EachRule) getString(value reflect.Value) string {
 		if value.IsNil() {
 			return ""
 		}
-		return value.Elem().String()
+		return getErrorKeyName(value.Elem().Interface())
 	default:
-		return value.String()
+		return getErrorKeyName(value.Interface())
 	}
 }
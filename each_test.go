@@ -3,8 +3,11 @@ package valid
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestEach(t *testing.T) {
@@ -18,20 +21,20 @@ func TestEach(t *testing.T) {
 		value interface{}
 		err   string
 	}{
-		{"t1", nil, "must be an iterable (map, slice or array)"},
+		{"t1", nil, "must be an iterable (map, slice, array or string)"},
 		{"t2", map[string]string{}, ""},
 		{"t3", map[string]string{"key1": "value1", "key2": "value2"}, ""},
 		{"t4", map[string]string{"key1": "", "key2": "value2", "key3": ""}, "key1: cannot be blank; key3: cannot be blank."},
 		{"t5", map[string]map[string]string{"key1": {"key1.1": "value1"}, "key2": {"key2.1": "value1"}}, ""},
-		{"t6", map[string]map[string]string{"": nil}, ": cannot be blank."},
+		{"t6", map[string]map[string]string{"": nil}, ": cannot be empty."},
 		{"t7", map[interface{}]interface{}{}, ""},
 		{"t8", map[interface{}]interface{}{"key1": struct{ foo string }{"foo"}}, ""},
 		{"t9", map[interface{}]interface{}{nil: "", "": "", "key1": nil}, ": cannot be blank; key1: cannot be blank."},
 		{"t10", []string{"value1", "value2", "value3"}, ""},
 		{"t11", []string{"", "value2", ""}, "0: cannot be blank; 2: cannot be blank."},
 		{"t12", []interface{}{struct{ foo string }{"foo"}}, ""},
-		{"t13", []interface{}{nil, a}, "0: cannot be blank; 1: cannot be blank."},
-		{"t14", []interface{}{c0, c1, f}, "0: cannot be blank."},
+		{"t13", []interface{}{nil, a}, "0: cannot be blank; 1: is required."},
+		{"t14", []interface{}{c0, c1, f}, "0: is required."},
 	}
 
 	for _, test := range tests {
@@ -41,6 +44,38 @@ func TestEach(t *testing.T) {
 	}
 }
 
+func TestEach_String(t *testing.T) {
+	digit := By(func(value interface{}) error {
+		if s := value.(string); s < "0" || s > "9" {
+			return errors.New("must be a digit")
+		}
+		return nil
+	})
+	var pin *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "1234", ""},
+		{"t2", "", ""},
+		{"t3", "12a4", "2: must be a digit."},
+		{"t4", "１2ä", "0: must be a digit; 2: must be a digit."},
+		{"t5", MyString("x1"), "0: must be a digit."},
+		{"t6", pin, ""},
+	}
+
+	for _, test := range tests {
+		err := Each(digit).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+		err = Each(digit).ValidateWithContext(context.Background(), test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Each(RuneLength(1, 1)).Validate("äöü")
+	assert.Nil(t, err)
+}
+
 func TestEachWithContext(t *testing.T) {
 	rule := Each(WithContext(func(ctx context.Context, value interface{}) error {
 		if !strings.Contains(value.(string), ctx.Value(contains).(string)) {
@@ -72,3 +107,198 @@ func TestEachWithContext(t *testing.T) {
 		assertError(t, test.err, err, test.tag)
 	}
 }
+
+func TestIndexFromContext(t *testing.T) {
+	rule := Each(WithContext(func(ctx context.Context, value interface{}) error {
+		index, ok := IndexFromContext(ctx)
+		if !ok {
+			return errors.New("no index")
+		}
+		if value.(string) == "" {
+			return fmt.Errorf("row %v cannot be blank", index)
+		}
+		return nil
+	}))
+
+	err := ValidateWithContext(context.Background(), []string{"a", "", "c", ""}, rule)
+	assertError(t, "1: row 1 cannot be blank; 3: row 3 cannot be blank.", err, "t1")
+	err = ValidateWithContext(context.Background(), map[string]string{"x": "", "y": "b"}, rule)
+	assertError(t, "x: row x cannot be blank.", err, "t2")
+	err = Validate([]string{"a"}, rule)
+	assertError(t, "0: no index.", err, "t3")
+
+	_, ok := IndexFromContext(context.Background())
+	assert.False(t, ok)
+	_, ok = IndexFromContext(nil)
+	assert.False(t, ok)
+}
+
+func TestEach_Map(t *testing.T) {
+	email := By(func(value interface{}) error {
+		if s, _ := value.(string); !strings.Contains(s, "@") {
+			return errors.New("must be a valid email address")
+		}
+		return nil
+	})
+	rule := Each(Map(
+		Key("name", Required),
+		Key("email", Required, email),
+	))
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []map[string]interface{}{}, ""},
+		{"t2", []map[string]interface{}{{"name": "a", "email": "a@b"}}, ""},
+		{"t3", []map[string]interface{}{{"name": "a", "email": "a@b"}, {"name": "b", "email": "c@d"}, {"name": "c", "email": "x"}}, "2: (email: must be a valid email address.)."},
+		{"t4", []map[string]interface{}{{"email": "a@b"}, {"name": "", "email": "x"}}, "0: (name: required key is missing.); 1: (email: must be a valid email address; name: cannot be blank.)."},
+		{"t5", []interface{}{map[string]interface{}{"name": "a", "email": "a@b", "age": 1}}, "0: (age: key not expected.)."},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateWithContext(context.Background(), test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+type regionID int
+
+func (id regionID) String() string {
+	return fmt.Sprintf("region-%d", int(id))
+}
+
+type quota struct {
+	Limit int
+}
+
+func (q quota) Validate() error {
+	return ValidateStruct(&q, Field(&q.Limit, Min(1)))
+}
+
+func TestEachRule_Keys(t *testing.T) {
+	rule := Each().Keys(Min(regionID(1)).Error("must be a known region"))
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[regionID]quota{}, ""},
+		{"t2", map[regionID]quota{1: {10}, 2: {20}}, ""},
+		{"t3", map[regionID]quota{1: {10}, 2: {-5}}, "region-2: (Limit: must be no less than 1.)."},
+		{"t4", map[regionID]quota{-1: {10}, 2: {20}}, "region--1: must be a known region."},
+		{"t5", map[regionID]quota{-1: {-5}, 3: {-5}}, "region--1: must be a known region; region-3: (Limit: must be no less than 1.)."},
+		{"t6", map[regionID]*quota{1: nil, 2: {-5}}, "region-2: (Limit: must be no less than 1.)."},
+		{"t7", []quota{{-5}}, "0: (Limit: must be no less than 1.)."},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateWithContext(context.Background(), test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// the value rules are applied along with the key rules
+	err := Validate(map[MyString]string{"a": "", "bc": "x"}, Each(Required).Keys(Length(2, 2)))
+	assertError(t, "a: the length must be exactly 2.", err, "t8")
+	err = Validate(map[MyString]string{"ab": "", "bc": "x"}, Each(Required).Keys(Length(2, 2)))
+	assertError(t, "ab: cannot be blank.", err, "t9")
+
+	// keys of non-string types are formatted with %v
+	err = Validate(map[int]string{1: "", 2: "x"}, Each(Required))
+	assertError(t, "1: cannot be blank.", err, "t10")
+
+	// the index is available to the key rules
+	keyRule := WithContext(func(ctx context.Context, value interface{}) error {
+		if index, ok := IndexFromContext(ctx); !ok || index != value {
+			return errors.New("no index")
+		}
+		return nil
+	})
+	err = ValidateWithContext(context.Background(), map[string]int{"a": 1}, Each().Keys(keyRule))
+	assert.Nil(t, err)
+}
+
+func TestEachRule_KeepKeys(t *testing.T) {
+	rule := Each(Required).KeepKeys()
+	err := Validate(map[int]string{1: "", 2: "x", 10: ""}, rule)
+	assertError(t, "1: cannot be blank; 10: cannot be blank.", err, "t1")
+	errs, ok := err.(KeyedErrors)
+	if assert.True(t, ok) {
+		keyed := errs.Keyed()
+		if assert.Len(t, keyed, 2) {
+			assert.Equal(t, 1, keyed[0].Key)
+			assert.Equal(t, 10, keyed[1].Key)
+			assert.EqualError(t, keyed[1].Err, "cannot be blank")
+		}
+	}
+
+	err = ValidateWithContext(context.Background(), map[regionID]string{3: ""}, rule)
+	assertError(t, "region-3: cannot be blank.", err, "t2")
+	if errs, ok := err.(KeyedErrors); assert.True(t, ok) {
+		key, _ := errs.Key("region-3")
+		assert.Equal(t, regionID(3), key)
+	}
+
+	// the errors of slices are still returned as Errors
+	err = Validate([]string{""}, rule)
+	_, ok = err.(Errors)
+	assert.True(t, ok)
+	assert.Nil(t, Validate(map[int]string{1: "x"}, rule))
+
+	// warnings are split without losing the keys
+	err = ValidateWithContext(WithWarnings(context.Background()), map[int]string{1: "", 2: "x"}, Each(Warn(Required), Length(2, 0)).KeepKeys())
+	errs2, warnings := SplitWarnings(err)
+	assertError(t, "2: the length must be no less than 2.", errs2, "t3")
+	assertError(t, "1: cannot be blank.", warnings, "t4")
+	if ws, ok := warnings.(KeyedErrors); assert.True(t, ok) {
+		assert.Equal(t, 1, ws.Keyed()[0].Key)
+	}
+}
+
+func TestEachRule_Then(t *testing.T) {
+	rule := Each(Required, Length(1, 5)).Then(Length(0, 3), Unique())
+	var nilTags *[]string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []string{"a", "b"}, ""},
+		{"t2", []string{}, ""},
+		{"t3", nilTags, ""},
+		{"t4", []string{"a", "", "toolong"}, "1: cannot be blank; 2: the length must be between 1 and 5."},
+		{"t5", []string{"a", "b", "c", "d"}, "the length must be no more than 3"},
+		{"t6", []string{"a", "b", "a"}, "must not contain duplicates (a is repeated at index 2)"},
+		// the elements are validated first
+		{"t7", []string{"a", "b", "c", ""}, "3: cannot be blank."},
+		{"t8", map[string]string{"a": "x", "b": "y", "c": "z", "d": "w"}, "the length must be no more than 3"},
+		{"t9", 123, "must be an iterable (map, slice, array or string)"},
+	}
+	for _, test := range tests {
+		err := Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateWithContext(context.Background(), test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// the rules of the collection receive the context without an element index
+	collection := WithContext(func(ctx context.Context, value interface{}) error {
+		if _, ok := IndexFromContext(ctx); ok {
+			return errors.New("unexpected index")
+		}
+		return nil
+	})
+	assert.Nil(t, ValidateWithContext(context.Background(), []string{"a"}, Each(Required).Then(collection)))
+
+	s := struct {
+		Tags []string
+	}{[]string{"go", "go"}}
+	err := ValidateStruct(&s, Field(&s.Tags, Each(Required).Then(Skip, Unique())))
+	assert.Nil(t, err)
+	err = ValidateStruct(&s, Field(&s.Tags, Each(Required).Then(Unique())))
+	assertError(t, "Tags: must not contain duplicates (go is repeated at index 1).", err, "t10")
+}
@@ -7,6 +7,7 @@ package valid
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -30,6 +31,9 @@ type (
 		code    string
 		message string
 		params  map[string]interface{}
+		// original is the message given to NewError, by which the error is looked up in the message catalog.
+		// It is empty if the message is customized by SetMessage.
+		original string
 	}
 
 	// Errors represents the validation errors that are indexed by struct field names, map or slice keys.
@@ -45,6 +49,53 @@ type (
 	internalError struct {
 		error
 	}
+
+	// OrderedErrors represents validation errors that keep the order in which their keys are added.
+	// The embedded Errors can be used to look up the error of a particular key.
+	OrderedErrors struct {
+		Errors
+		keys []string
+	}
+
+	// KeyedErrors represents the validation errors of a map that retain the original map keys, as returned by
+	// Each rules configured with KeepKeys. The embedded Errors is keyed by the map keys formatted with "%v",
+	// so the error string and JSON output are the same as those of Errors.
+	KeyedErrors struct {
+		Errors
+		keys map[string]interface{}
+	}
+
+	// KeyedError is an error of KeyedErrors together with its original map key.
+	KeyedError struct {
+		// Key is the original map key.
+		Key interface{}
+		// Err is the validation error of the map entry.
+		Err error
+	}
+
+	// FieldErrors represents the validation errors of a struct as a list of the fields that failed, in the order
+	// the fields are specified, as returned by a StructValidator configured with AsSlice. Unlike Errors, a field
+	// that is specified more than once (e.g. with different validation groups) may appear more than once.
+	FieldErrors []FieldError
+
+	// FieldError is an error of FieldErrors. It is also the error returned by a StructValidator configured with
+	// FailFast for the field that fails.
+	FieldError struct {
+		// Field is the path of the field, with the keys of nested errors separated by dots (e.g. "Address.Zip").
+		Field string `json:"field"`
+		// Message is the error message.
+		Message string `json:"message"`
+		// Err is the validation error of the field.
+		Err error `json:"-"`
+	}
+
+	// ErrorGroup represents a distinct error message together with the paths of all leaf errors having that message.
+	ErrorGroup struct {
+		// Message is the error message shared by the leaf errors.
+		Message string
+		// Paths lists the paths of the leaf errors, in the same format as those passed to Errors.Walk.
+		Paths [][]string
+	}
 )
 
 // NewInternalError wraps a given error into an InternalError.
@@ -90,8 +141,10 @@ func (e ErrorObject) Params() map[string]interface{} {
 }
 
 // SetMessage set the error's message.
+// A customized message is never replaced by the message catalog, even if a locale is set.
 func (e ErrorObject) SetMessage(message string) Error {
 	e.message = message
+	e.original = ""
 	return e
 }
 
@@ -118,27 +171,48 @@ func (es Errors) Error() string {
 		return ""
 	}
 
-	keys := make([]string, len(es))
-	i := 0
-	for key := range es {
-		keys[i] = key
-		i++
-	}
-	sort.Strings(keys)
+	return formatErrors(es.sortedKeys(), es)
+}
 
-	var s strings.Builder
-	for i, key := range keys {
-		if i > 0 {
-			s.WriteString("; ")
-		}
-		if errs, ok := es[key].(Errors); ok {
-			_, _ = fmt.Fprintf(&s, "%v: (%v)", key, errs)
-		} else {
-			_, _ = fmt.Fprintf(&s, "%v: %v", key, es[key].Error())
+// Walk traverses the errors in the order of their keys and calls fn for every leaf error.
+// The path passed to fn lists the keys leading to the leaf error, starting from the top level.
+// Nested Errors (e.g. those returned for struct fields, Each and Map rules) are traversed recursively,
+// while nil errors are skipped.
+func (es Errors) Walk(fn func(path []string, err error)) {
+	es.walk(nil, fn)
+}
+
+func (es Errors) walk(prefix []string, fn func(path []string, err error)) {
+	walkErrors(prefix, es.sortedKeys(), es, fn)
+}
+
+// Dedup groups the leaf errors by their messages, so that a message repeated many times (e.g. by Each rules
+// validating large slices) is reported only once together with the paths where it occurs.
+// The groups are ordered by the first occurrence of their messages in the Walk order. Errors itself is not modified.
+func (es Errors) Dedup() []ErrorGroup {
+	var groups []ErrorGroup
+	index := map[string]int{}
+	es.Walk(func(path []string, err error) {
+		msg := err.Error()
+		i, ok := index[msg]
+		if !ok {
+			i = len(groups)
+			index[msg] = i
+			groups = append(groups, ErrorGroup{Message: msg})
 		}
+		groups[i].Paths = append(groups[i].Paths, path)
+	})
+	return groups
+}
+
+// sortedKeys returns the keys of Errors in ascending order.
+func (es Errors) sortedKeys() []string {
+	keys := make([]string, 0, len(es))
+	for key := range es {
+		keys = append(keys, key)
 	}
-	s.WriteString(".")
-	return s.String()
+	sort.Strings(keys)
+	return keys
 }
 
 // MarshalJSON converts the Errors into a valid JSON.
@@ -177,11 +251,230 @@ func (es Errors) Filter() error {
 	return es
 }
 
+// Set records the error under the given key and returns back the Errors for chaining.
+// A nil error is skipped, so the results of several validations can be collected directly:
+//
+//	err := valid.Errors{}.
+//	    Set("name", valid.Validate(c.Name, valid.Required)).
+//	    Set("email", valid.Validate(c.Email, is.Email)).
+//	    Filter()
+func (es Errors) Set(key string, err error) Errors {
+	if err != nil {
+		es[key] = err
+	}
+	return es
+}
+
+// Add records an error with the given message under the given key and returns back the Errors for chaining.
+// An empty message is skipped.
+func (es Errors) Add(key, message string) Errors {
+	if message != "" {
+		es[key] = errors.New(message)
+	}
+	return es
+}
+
+// Count returns the number of leaf errors, counting nested Errors (e.g. those returned for struct fields,
+// Each and Map rules) recursively. Nil errors are not counted.
+func (es Errors) Count() int {
+	n := 0
+	es.Walk(func([]string, error) {
+		n++
+	})
+	return n
+}
+
+// Keys returns the keys of the errors in the order they were added.
+func (es OrderedErrors) Keys() []string {
+	return es.keys
+}
+
+// Error returns the error string of OrderedErrors, listing the errors in the order they were added.
+func (es OrderedErrors) Error() string {
+	if len(es.keys) == 0 {
+		return ""
+	}
+	return formatErrors(es.keys, es.Errors)
+}
+
+// Walk traverses the errors in the order they were added and calls fn for every leaf error.
+// Please refer to Errors.Walk for more details.
+func (es OrderedErrors) Walk(fn func(path []string, err error)) {
+	es.walk(nil, fn)
+}
+
+func (es OrderedErrors) walk(prefix []string, fn func(path []string, err error)) {
+	walkErrors(prefix, es.keys, es.Errors, fn)
+}
+
+// MarshalJSON converts the OrderedErrors into a JSON object whose keys are in the order they were added.
+func (es OrderedErrors) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, key := range es.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		var v []byte
+		if ms, ok := es.Errors[key].(json.Marshaler); ok {
+			v, err = ms.MarshalJSON()
+		} else {
+			v, err = json.Marshal(es.Errors[key].Error())
+		}
+		if err != nil {
+			return nil, err
+		}
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// Error returns the error string of FieldErrors, listing the errors in their order.
+func (es FieldErrors) Error() string {
+	if len(es) == 0 {
+		return ""
+	}
+	var s strings.Builder
+	for i, e := range es {
+		if i > 0 {
+			s.WriteString("; ")
+		}
+		_, _ = fmt.Fprintf(&s, "%v: %v", e.Field, e.Message)
+	}
+	s.WriteString(".")
+	return s.String()
+}
+
+// Walk calls fn for every error in their order. The path passed to fn only consists of the field path.
+// Please refer to Errors.Walk for more details.
+func (es FieldErrors) Walk(fn func(path []string, err error)) {
+	es.walk(nil, fn)
+}
+
+func (es FieldErrors) walk(prefix []string, fn func(path []string, err error)) {
+	for _, e := range es {
+		fn(append(prefix[:len(prefix):len(prefix)], e.Field), e.Err)
+	}
+}
+
+// Error returns the error string of the field error, which is the same as that of Errors holding the error
+// under the field name, e.g. "Name: cannot be blank.".
+func (e FieldError) Error() string {
+	return Errors{e.Field: e.Err}.Error()
+}
+
+// Unwrap returns the validation error of the field.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Walk calls fn for every leaf error of the field, with the field name as the first element of the path.
+// Please refer to Errors.Walk for more details.
+func (e FieldError) Walk(fn func(path []string, err error)) {
+	Errors{e.Field: e.Err}.Walk(fn)
+}
+
+// withErrors returns FieldErrors made of the given errors, which must be assigned to the same fields as those of es.
+func (es FieldErrors) withErrors(errs []error) FieldErrors {
+	var result FieldErrors
+	for i, err := range errs {
+		if err != nil {
+			result = append(result, FieldError{Field: es[i].Field, Message: err.Error(), Err: err})
+		}
+	}
+	return result
+}
+
+// Key returns the original map key of the error recorded under the given string key.
+// The boolean result is false if there is no such error.
+func (es KeyedErrors) Key(name string) (interface{}, bool) {
+	if _, ok := es.Errors[name]; !ok {
+		return nil, false
+	}
+	key, ok := es.keys[name]
+	return key, ok
+}
+
+// Keyed returns the errors together with their original map keys, in the order of the string keys.
+func (es KeyedErrors) Keyed() []KeyedError {
+	names := es.sortedKeys()
+	result := make([]KeyedError, 0, len(names))
+	for _, name := range names {
+		result = append(result, KeyedError{Key: es.keys[name], Err: es.Errors[name]})
+	}
+	return result
+}
+
+// withErrors returns KeyedErrors made of the given errors and the original keys of es, or nil if errs is nil.
+func (es KeyedErrors) withErrors(errs error) error {
+	if errs == nil {
+		return nil
+	}
+	return KeyedErrors{Errors: errs.(Errors), keys: es.keys}
+}
+
+// add adds an error under the given key, keeping the position of the key if it already exists.
+func (es *OrderedErrors) add(key string, err error) {
+	if es.Errors == nil {
+		es.Errors = Errors{}
+	}
+	if _, ok := es.Errors[key]; !ok {
+		es.keys = append(es.keys, key)
+	}
+	es.Errors[key] = err
+}
+
+// formatErrors builds the error string of the given errors listed in the order of keys.
+func formatErrors(keys []string, es Errors) string {
+	var s strings.Builder
+	for i, key := range keys {
+		if i > 0 {
+			s.WriteString("; ")
+		}
+		switch errs := es[key].(type) {
+		case Errors, OrderedErrors, KeyedErrors, FieldErrors:
+			_, _ = fmt.Fprintf(&s, "%v: (%v)", key, errs)
+		default:
+			_, _ = fmt.Fprintf(&s, "%v: %v", key, errs.Error())
+		}
+	}
+	s.WriteString(".")
+	return s.String()
+}
+
+// walkErrors calls fn for every leaf error found in the given errors listed in the order of keys.
+func walkErrors(prefix []string, keys []string, es Errors, fn func(path []string, err error)) {
+	for _, key := range keys {
+		path := append(prefix[:len(prefix):len(prefix)], key)
+		switch err := es[key].(type) {
+		case nil:
+		case Errors:
+			err.walk(path, fn)
+		case OrderedErrors:
+			err.walk(path, fn)
+		case KeyedErrors:
+			err.walk(path, fn)
+		case FieldErrors:
+			err.walk(path, fn)
+		default:
+			fn(path, err)
+		}
+	}
+}
+
 // NewError create new validation error.
 func NewError(code, message string) Error {
 	return ErrorObject{
-		code:    code,
-		message: message,
+		code:     code,
+		message:  message,
+		original: message,
 	}
 }
 
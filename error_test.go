@@ -5,7 +5,9 @@
 package valid
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -70,6 +72,159 @@ func TestErrors_Filter(t *testing.T) {
 	assert.Nil(t, errs.Filter())
 }
 
+func TestErrors_Set(t *testing.T) {
+	errs := Errors{}
+	res := errs.Set("B", errors.New("B1")).Set("C", nil).Set("A", ErrRequired)
+	assert.Equal(t, errs, res)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, "A: cannot be blank; B: B1.", errs.Error())
+
+	assert.Nil(t, Errors{}.Set("A", nil).Filter())
+	assert.Equal(t, "A: A1.", Errors{}.Set("A", errors.New("A1")).Filter().Error())
+}
+
+func TestErrors_Add(t *testing.T) {
+	errs := Errors{}.Add("B", "B1").Add("C", "").Set("A", errors.New("A1"))
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, "A: A1; B: B1.", errs.Error())
+	assert.Nil(t, Errors{}.Add("A", "").Filter())
+}
+
+func TestErrors_Walk(t *testing.T) {
+	errs := Errors{
+		"B": Errors{
+			"2": errors.New("B2"),
+			"1": Errors{
+				"x": errors.New("B1x"),
+			},
+		},
+		"C": nil,
+		"A": errors.New("A1"),
+	}
+
+	var paths []string
+	var messages []string
+	errs.Walk(func(path []string, err error) {
+		paths = append(paths, strings.Join(path, "."))
+		messages = append(messages, err.Error())
+	})
+	assert.Equal(t, []string{"A", "B.1.x", "B.2"}, paths)
+	assert.Equal(t, []string{"A1", "B1x", "B2"}, messages)
+
+	called := false
+	Errors{}.Walk(func([]string, error) { called = true })
+	assert.False(t, called)
+}
+
+func TestErrors_Count(t *testing.T) {
+	assert.Equal(t, 0, Errors{}.Count())
+	assert.Equal(t, 0, Errors{"a": nil}.Count())
+	ordered := OrderedErrors{}
+	ordered.add("x", errors.New("x1"))
+	ordered.add("y", Errors{"z": errors.New("z1")})
+	errs := Errors{
+		"a": errors.New("a1"),
+		"b": Errors{"c": errors.New("c1"), "d": Errors{"e": errors.New("e1"), "f": errors.New("f1")}},
+		"g": ordered,
+		"h": nil,
+	}
+	assert.Equal(t, 6, errs.Count())
+	assert.Equal(t, 2, ordered.Count())
+}
+
+func TestErrors_Dedup(t *testing.T) {
+	errs := Errors{
+		"items": Errors{
+			"0": Errors{"name": errors.New("cannot be blank")},
+			"1": Errors{"name": errors.New("cannot be blank"), "price": errors.New("must be no less than 0")},
+			"2": Errors{"name": errors.New("cannot be blank")},
+		},
+		"email": errors.New("cannot be blank"),
+	}
+
+	groups := errs.Dedup()
+	assert.Equal(t, []ErrorGroup{
+		{Message: "cannot be blank", Paths: [][]string{{"email"}, {"items", "0", "name"}, {"items", "1", "name"}, {"items", "2", "name"}}},
+		{Message: "must be no less than 0", Paths: [][]string{{"items", "1", "price"}}},
+	}, groups)
+	// the original errors are left untouched
+	assert.Len(t, errs["items"], 3)
+
+	assert.Nil(t, Errors{}.Dedup())
+}
+
+func TestOrderedErrors(t *testing.T) {
+	var errs OrderedErrors
+	errs.add("B", errors.New("B1"))
+	errs.add("A", Errors{"2": errors.New("A2"), "1": errors.New("A1")})
+	errs.add("C", errors.New("C1"))
+	errs.add("B", errors.New("B2"))
+
+	assert.Equal(t, []string{"B", "A", "C"}, errs.Keys())
+	assert.Equal(t, "B: B2; A: (1: A1; 2: A2.); C: C1.", errs.Error())
+	assert.Equal(t, map[string]string{"A": "1: A1; 2: A2.", "B": "B2", "C": "C1"}, errs.Map())
+
+	var paths []string
+	errs.Walk(func(path []string, err error) {
+		paths = append(paths, strings.Join(path, "."))
+	})
+	assert.Equal(t, []string{"B", "A.1", "A.2", "C"}, paths)
+
+	b, err := json.Marshal(errs)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"B":"B2","A":{"1":"A1","2":"A2"},"C":"C1"}`, string(b))
+
+	assert.Equal(t, "", OrderedErrors{}.Error())
+	b, _ = json.Marshal(OrderedErrors{})
+	assert.Equal(t, "{}", string(b))
+}
+
+func TestKeyedErrors(t *testing.T) {
+	errs := KeyedErrors{
+		Errors: Errors{"1": errors.New("A1"), "10": Errors{"name": errors.New("B1")}},
+		keys:   map[string]interface{}{"1": 1, "10": 10},
+	}
+	assert.Equal(t, "1: A1; 10: (name: B1.).", errs.Error())
+	assert.Equal(t, []KeyedError{{Key: 1, Err: errs.Errors["1"]}, {Key: 10, Err: errs.Errors["10"]}}, errs.Keyed())
+	key, ok := errs.Key("10")
+	assert.True(t, ok)
+	assert.Equal(t, 10, key)
+	_, ok = errs.Key("2")
+	assert.False(t, ok)
+	b, err := json.Marshal(errs)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"1":"A1","10":{"name":"B1"}}`, string(b))
+	assert.Equal(t, 2, errs.Count())
+
+	// nested KeyedErrors are formatted and walked like Errors
+	outer := Errors{"quotas": errs}
+	assert.Equal(t, "quotas: (1: A1; 10: (name: B1.).).", outer.Error())
+	assert.Equal(t, 2, outer.Count())
+}
+
+func TestFieldErrors(t *testing.T) {
+	es := FieldErrors{
+		{Field: "name", Message: "cannot be blank", Err: ErrRequired},
+		{Field: "email", Message: "must be a valid email", Err: errors.New("must be a valid email")},
+		{Field: "email", Message: "is taken", Err: errors.New("is taken")},
+	}
+	assert.Equal(t, "name: cannot be blank; email: must be a valid email; email: is taken.", es.Error())
+	assert.Equal(t, "", FieldErrors{}.Error())
+
+	var paths []string
+	es.Walk(func(path []string, err error) {
+		paths = append(paths, strings.Join(path, ".")+"="+err.Error())
+	})
+	assert.Equal(t, []string{"name=cannot be blank", "email=must be a valid email", "email=is taken"}, paths)
+	assert.Equal(t, 3, Errors{"form": es}.Count())
+	assert.Equal(t, "form: (name: cannot be blank; email: must be a valid email; email: is taken.).", Errors{"form": es}.Error())
+
+	b, err := json.Marshal(es)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"field":"name","message":"cannot be blank"},{"field":"email","message":"must be a valid email"},`+
+		`{"field":"email","message":"is taken"}]`, string(b))
+}
+
 func TestErrorObject_SetCode(t *testing.T) {
 	err := NewError("A", "msg").(ErrorObject)
 
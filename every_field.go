@@ -0,0 +1,39 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import "reflect"
+
+// EveryField returns the field rules that apply the given rules to every exported field of a struct,
+// in the order the fields are declared. The struct must be specified as a pointer to it.
+// The result can be passed to ValidateStruct, optionally together with the rules of individual fields:
+//
+//	err := valid.ValidateStruct(&t, valid.EveryField(&t, valid.Required)...)
+//
+// Unexported fields and embedded (anonymous) fields are skipped. To also validate the fields promoted from
+// an embedded struct, include them explicitly, e.g. append(valid.EveryField(&t.Base, rules...), valid.EveryField(&t, rules...)...).
+// If structPtr is not a non-nil pointer to a struct, nil is returned.
+func EveryField(structPtr interface{}, rules ...Rule) []*FieldRules {
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	value = value.Elem()
+	var fields []*FieldRules
+	for i := 0; i < value.NumField(); i++ {
+		if sf := value.Type().Field(i); sf.Anonymous || !sf.IsExported() {
+			continue
+		}
+		fields = append(fields, Field(value.Field(i).Addr().Interface(), rules...))
+	}
+	return fields
+}
+
+// ValidateEveryField validates every exported field of a struct against the given rules.
+// It is a shortcut to ValidateStruct(structPtr, EveryField(structPtr, rules...)...).
+// Please refer to EveryField for the fields being validated.
+func ValidateEveryField(structPtr interface{}, rules ...Rule) error {
+	return ValidateStruct(structPtr, EveryField(structPtr, rules...)...)
+}
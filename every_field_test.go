@@ -0,0 +1,58 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type translations struct {
+	EN string
+	DE string `json:"de"`
+	FR string
+	id string
+}
+
+type pageTranslations struct {
+	translations
+	Title string
+}
+
+func TestEveryField(t *testing.T) {
+	tr := translations{EN: "hello"}
+	fields := EveryField(&tr, Required)
+	assert.Len(t, fields, 3)
+	assert.Equal(t, &tr.EN, fields[0].fieldPtr)
+	assert.Equal(t, &tr.FR, fields[2].fieldPtr)
+
+	err := ValidateStruct(&tr, fields...)
+	assert.EqualError(t, err, "FR: cannot be blank; de: cannot be blank.")
+
+	// combined with the rules of individual fields
+	err = ValidateStruct(&tr, append(EveryField(&tr, Required), Field(&tr.EN, Length(10, 20)))...)
+	assert.EqualError(t, err, "EN: the length must be between 10 and 20; FR: cannot be blank; de: cannot be blank.")
+
+	// embedded structs are skipped unless included explicitly
+	p := pageTranslations{Title: "x"}
+	assert.Nil(t, ValidateEveryField(&p, Required))
+	err = ValidateStruct(&p, append(EveryField(&p.translations, Required), EveryField(&p, Required)...)...)
+	assert.EqualError(t, err, "EN: cannot be blank; FR: cannot be blank; de: cannot be blank.")
+
+	assert.Nil(t, EveryField(tr, Required))
+	assert.Nil(t, EveryField((*translations)(nil), Required))
+	var s string
+	assert.Nil(t, EveryField(&s, Required))
+}
+
+func TestValidateEveryField(t *testing.T) {
+	tr := translations{EN: "hello", DE: "hallo", FR: "bonjour"}
+	assert.Nil(t, ValidateEveryField(&tr, Required))
+	tr.DE = ""
+	assert.EqualError(t, ValidateEveryField(&tr, Required), "de: cannot be blank.")
+	assert.Nil(t, ValidateEveryField((*translations)(nil), Required))
+	assert.Equal(t, NewInternalError(ErrStructPointer), ValidateEveryField(tr, Required))
+}
@@ -128,7 +128,7 @@ func Example_five() {
 	)
 	fmt.Println(err)
 	// Output:
-	// Level: cannot be blank; Name: cannot be blank.
+	// Level: is required; Name: cannot be blank.
 }
 
 type contextKey int
@@ -190,3 +190,160 @@ func Example_seven() {
 	// Output:
 	// Address: (State: must be in a valid format; Street: the length must be between 5 and 50.); Email: must be a valid email address.
 }
+
+func Example_eight() {
+	type Order struct {
+		Name      string
+		Addresses []Address
+	}
+
+	o := Order{
+		Name: "Winter sale",
+		Addresses: []Address{
+			{State: "MD", Zip: "12345"},
+			{Street: "123 Main St", City: "Vienna", State: "VA", Zip: "12345"},
+			{City: "Unknown", State: "NC", Zip: "123"},
+		},
+	}
+	err := valid.ValidateStruct(&o,
+		valid.Field(&o.Name, valid.Required),
+		// each address is validated by its own Validate() method
+		valid.Field(&o.Addresses, valid.Required),
+	)
+	fmt.Println(err)
+	// Output:
+	// Addresses: (0: (City: cannot be blank; Street: cannot be blank.); 2: (Street: cannot be blank; Zip: must be in a valid format.).).
+}
+
+func Example_nine() {
+	// rows decoded from a JSON array such as [{"name": "John", "email": "john@example.com"}, ...]
+	rows := []map[string]interface{}{
+		{"name": "John", "email": "john@example.com"},
+		{"name": "Jane", "email": "jane@example.com", "age": 30},
+		{"name": "", "email": "bob"},
+	}
+	err := valid.Validate(rows,
+		valid.Each(valid.Map(
+			valid.Key("name", valid.Required),
+			valid.Key("email", valid.Required, is.Email),
+			valid.Key("age", valid.Min(18)).Optional(),
+		)),
+	)
+	fmt.Println(err)
+	// Output:
+	// 2: (email: must be a valid email address; name: cannot be blank.).
+}
+
+type Currency string
+
+func (c Currency) String() string {
+	return "currency " + string(c)
+}
+
+type Price struct {
+	Amount int
+}
+
+func (p Price) Validate() error {
+	return valid.ValidateStruct(&p, valid.Field(&p.Amount, valid.Required, valid.Min(1)))
+}
+
+func Example_ten() {
+	prices := map[Currency]Price{
+		"USD": {Amount: 100},
+		"EUR": {},
+		"XYZ": {Amount: 90},
+	}
+	// the values are validated by Price.Validate after the keys pass the key rules
+	err := valid.Validate(prices, valid.Each().Keys(valid.In(Currency("USD"), Currency("EUR"))))
+	fmt.Println(err)
+	// Output:
+	// currency EUR: (Amount: is required.); currency XYZ: must be one of: currency USD, currency EUR.
+}
+
+func Example_eleven() {
+	type Address struct {
+		Country   string
+		StateCode string
+	}
+	usOrCanada := regexp.MustCompile(`^(US|CA)$`)
+	addresses := []Address{
+		{Country: "US", StateCode: "NY"},
+		{Country: "CA"},
+		{Country: "FR", StateCode: "75"},
+	}
+	for _, a := range addresses {
+		err := valid.ValidateStruct(&a,
+			valid.Field(&a.StateCode,
+				valid.WhenFieldMatches(func() interface{} { return a.Country }, usOrCanada,
+					valid.Required, valid.Length(2, 2),
+				).Else(valid.Empty),
+			),
+		)
+		fmt.Println(err)
+	}
+	// Output:
+	// <nil>
+	// StateCode: cannot be blank.
+	// StateCode: must be blank.
+}
+
+func Example_twelve() {
+	type Service struct {
+		Name   string
+		Config map[string]string
+	}
+	s := Service{
+		Name:   "api",
+		Config: map[string]string{"timeout": "5", "debug": "true"},
+	}
+	// the Map rule receives the map value of the field, and its errors are nested under the field name
+	err := valid.ValidateStruct(&s,
+		valid.Field(&s.Name, valid.Required),
+		valid.Field(&s.Config, valid.Required, valid.Map(
+			valid.Key("timeout", valid.Required, is.Digit, valid.Length(2, 3)),
+			valid.Key("retries", valid.Required),
+		)),
+	)
+	fmt.Println(err)
+	// Output:
+	// Config: (debug: key not expected; retries: required key is missing; timeout: the length must be between 2 and 3.).
+}
+
+func Example_thirteen() {
+	type Message struct {
+		To string
+		CC string
+	}
+	m := Message{
+		To: "john@example.com",
+		CC: "jane@example.com, not-an-email, bob@example.com",
+	}
+	// the CC field holds a comma-separated list of emails, and each of them is validated
+	err := valid.ValidateStruct(&m,
+		valid.Field(&m.To, valid.Required, is.EmailFormat),
+		valid.Field(&m.CC, valid.Separated(",", is.EmailFormat)),
+	)
+	fmt.Println(err)
+	// Output:
+	// CC: (1: must be a valid email address.).
+}
+
+func Example_fourteen() {
+	type Contact struct {
+		Name    string
+		Contact string
+	}
+	c := Contact{
+		Name:    "John",
+		Contact: "john at example.com",
+	}
+	// the Contact field may hold either an email address or a phone number
+	err := valid.ValidateStruct(&c,
+		valid.Field(&c.Name, valid.Required),
+		valid.Field(&c.Contact, valid.Required, valid.Or(is.EmailFormat, is.E164)),
+	)
+	fmt.Println(err)
+	// Output:
+	// Contact: must satisfy one of: must be a valid email address; must be a valid E164 number.
+}
@@ -0,0 +1,137 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"sort"
+)
+
+// GetterRules represents a rule set associated with a named getter.
+type GetterRules struct {
+	name   string
+	getter func() interface{}
+	rules  []Rule
+}
+
+// Getter specifies a named getter and the rules used to validate the value it returns.
+// The getter is typically a method value of the object being validated, wrapped to return interface{}:
+//
+//	valid.Getter("name", func() interface{} { return u.Name() }, valid.Required)
+//
+// Please refer to ValidateGetters for more details.
+func Getter(name string, getter func() interface{}, rules ...Rule) *GetterRules {
+	return &GetterRules{
+		name:   name,
+		getter: getter,
+		rules:  rules,
+	}
+}
+
+// ValidateGetters validates the values returned by the given getters against their rules.
+// It is the counterpart of ValidateStruct for objects that expose their data through getter methods
+// (e.g. domain models hidden behind interfaces) and thus have no fields whose pointers can be passed to Field.
+// For example,
+//
+//	type User interface {
+//	    Name() string
+//	    Email() string
+//	}
+//
+//	err := valid.ValidateGetters(
+//	    valid.Getter("name", func() interface{} { return u.Name() }, valid.Required),
+//	    valid.Getter("email", func() interface{} { return u.Email() }, valid.Required, is.Email),
+//	)
+//
+// The validation errors are returned as Errors keyed by the getter names. A getter is called exactly once
+// per validation. Like ValidateStruct, an internal error stops the validation and is returned directly,
+// and an error wrapped with Abort stops validating the getters following the one that failed.
+func ValidateGetters(getters ...*GetterRules) error {
+	return validateGetters(nil, getters)
+}
+
+// ValidateGettersWithContext validates the values returned by the given getters with the given context.
+// The only difference between ValidateGettersWithContext and ValidateGetters is that the former will
+// validate the values with the provided context.
+// Please refer to ValidateGetters for the detailed instructions on how to use this function.
+func ValidateGettersWithContext(ctx context.Context, getters ...*GetterRules) error {
+	return validateGetters(ctx, getters)
+}
+
+// ValueRules represents a value together with the rules used to validate it. Please refer to ValidateEach for details.
+type ValueRules struct {
+	Value interface{}
+	Rules []Rule
+}
+
+// ValidateEach validates the given named values against their rules. It is a lightweight alternative to
+// ValidateStruct for an ad-hoc group of values, e.g. the values computed by the methods of an object:
+//
+//	err := valid.ValidateEach(map[string]valid.ValueRules{
+//	    "total":    {Value: order.Total(), Rules: []valid.Rule{valid.Min(0)}},
+//	    "currency": {Value: order.Currency(), Rules: []valid.Rule{valid.Required, is.CurrencyCode}},
+//	})
+//
+// The validation errors are returned as Errors keyed by the value names, and nil is returned if all values are valid.
+// The values are validated in the order of their names. Like ValidateGetters, an internal error stops the validation
+// and is returned directly, and an error wrapped with Abort stops validating the values following the one that failed.
+func ValidateEach(values map[string]ValueRules) error {
+	return validateGetters(nil, valueGetters(values))
+}
+
+// ValidateEachWithContext validates the given named values with the given context.
+// The only difference between ValidateEachWithContext and ValidateEach is that the former will
+// validate the values with the provided context.
+// Please refer to ValidateEach for the detailed instructions on how to use this function.
+func ValidateEachWithContext(ctx context.Context, values map[string]ValueRules) error {
+	return validateGetters(ctx, valueGetters(values))
+}
+
+// valueGetters returns the getters of the named values sorted by the names.
+func valueGetters(values map[string]ValueRules) []*GetterRules {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	getters := make([]*GetterRules, len(names))
+	for i, name := range names {
+		vr := values[name]
+		getters[i] = Getter(name, func() interface{} { return vr.Value }, vr.Rules...)
+	}
+	return getters
+}
+
+// validateGetters validates the getter values. If ctx is nil, the values are validated without a context.
+func validateGetters(ctx context.Context, getters []*GetterRules) error {
+	errs := Errors{}
+	for _, gr := range getters {
+		var err error
+		if ctx == nil {
+			err = Validate(gr.getter(), gr.rules...)
+		} else {
+			err = ValidateWithContext(ctx, gr.getter(), gr.rules...)
+		}
+		if err == nil {
+			continue
+		}
+		if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+			return err
+		}
+		ae, aborted := err.(abortError)
+		if aborted {
+			err = ae.error
+		}
+		errs[gr.name] = withErrorField(err, gr.name)
+		if aborted {
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
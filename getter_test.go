@@ -0,0 +1,145 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type account interface {
+	Name() string
+	Email() string
+	Tags() []string
+}
+
+type accountModel struct {
+	name, email string
+	tags        []string
+}
+
+func (a accountModel) Name() string   { return a.name }
+func (a accountModel) Email() string  { return a.email }
+func (a accountModel) Tags() []string { return a.tags }
+
+func accountGetters(a account, rules ...Rule) []*GetterRules {
+	return []*GetterRules{
+		Getter("name", func() interface{} { return a.Name() }, append([]Rule{Required}, rules...)...),
+		Getter("email", func() interface{} { return a.Email() }, Required, Length(5, 20)),
+		Getter("tags", func() interface{} { return a.Tags() }, Each(Required)),
+	}
+}
+
+func TestValidateGetters(t *testing.T) {
+	tests := []struct {
+		tag   string
+		model account
+		err   string
+	}{
+		{"t1", accountModel{"John", "john@example.com", nil}, ""},
+		{"t2", accountModel{"", "john@example.com", []string{"a"}}, "name: cannot be blank."},
+		{"t3", accountModel{"", "abc", []string{"a", ""}}, "email: the length must be between 5 and 20; name: cannot be blank; tags: (1: cannot be blank.)."},
+	}
+
+	for _, test := range tests {
+		err := ValidateGetters(accountGetters(test.model)...)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateGettersWithContext(context.Background(), accountGetters(test.model)...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, ValidateGetters())
+}
+
+func TestValidateGetters_Calls(t *testing.T) {
+	calls := 0
+	getter := Getter("count", func() interface{} {
+		calls++
+		return calls
+	}, Min(5))
+	assertError(t, "count: must be no less than 5.", ValidateGetters(getter), "t1")
+	assert.Equal(t, 1, calls)
+}
+
+func TestValidateGetters_Abort(t *testing.T) {
+	m := accountModel{"", "abc", nil}
+	abort := By(func(value interface{}) error {
+		if value == "" {
+			return Abort(errors.New("is missing"))
+		}
+		return nil
+	})
+	err := ValidateGetters(
+		Getter("name", func() interface{} { return m.Name() }, abort),
+		Getter("email", func() interface{} { return m.Email() }, Length(5, 20)),
+	)
+	assertError(t, "name: is missing.", err, "t1")
+}
+
+func TestValidateGetters_InternalError(t *testing.T) {
+	internal := By(func(value interface{}) error {
+		return NewInternalError(errors.New("boom"))
+	})
+	err := ValidateGetters(
+		Getter("name", func() interface{} { return "John" }, internal),
+		Getter("email", func() interface{} { return "" }, Required),
+	)
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+		assert.Equal(t, "boom", err.Error())
+	}
+}
+
+func TestValidateGettersWithContext(t *testing.T) {
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if value != ctx.Value(contains) {
+			return errors.New("unexpected value")
+		}
+		return nil
+	})
+	ctx := context.WithValue(context.Background(), contains, "abc")
+	err := ValidateGettersWithContext(ctx,
+		Getter("a", func() interface{} { return "abc" }, rule),
+		Getter("b", func() interface{} { return "xyz" }, rule),
+	)
+	assertError(t, "b: unexpected value.", err, "t1")
+}
+
+func TestValidateEach(t *testing.T) {
+	m := accountModel{"", "abc", []string{"a", ""}}
+	values := map[string]ValueRules{
+		"name":  {Value: m.Name(), Rules: []Rule{Required}},
+		"email": {Value: m.Email(), Rules: []Rule{Required, Length(5, 20)}},
+		"tags":  {Value: m.Tags(), Rules: []Rule{Each(Required)}},
+		"count": {Value: len(m.Tags()), Rules: []Rule{Max(5)}},
+	}
+	err := ValidateEach(values)
+	assertError(t, "email: the length must be between 5 and 20; name: cannot be blank; tags: (1: cannot be blank.).", err, "t1")
+	if assert.IsType(t, Errors{}, err) {
+		assert.Len(t, err.(Errors), 3)
+	}
+	err = ValidateEachWithContext(context.Background(), values)
+	assertError(t, "email: the length must be between 5 and 20; name: cannot be blank; tags: (1: cannot be blank.).", err, "t2")
+
+	// nil is returned rather than empty Errors if all values are valid
+	err = ValidateEach(map[string]ValueRules{"count": {Value: 3, Rules: []Rule{Max(5)}}})
+	assert.True(t, err == nil)
+	assert.Nil(t, ValidateEach(nil))
+
+	// the values are validated in the order of their names
+	abort := By(func(value interface{}) error {
+		return Abort(errors.New("is missing"))
+	})
+	err = ValidateEach(map[string]ValueRules{
+		"b": {Value: "", Rules: []Rule{abort}},
+		"a": {Value: "", Rules: []Rule{Required}},
+		"c": {Value: "", Rules: []Rule{Required}},
+	})
+	assertError(t, "a: cannot be blank; b: is missing.", err, "t3")
+}
@@ -0,0 +1,60 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"reflect"
+	"time"
+)
+
+// ErrImmutable is the error that returns when a value differs from its previous version.
+var ErrImmutable = NewError("validation_immutable", "cannot be changed")
+
+// Immutable returns a validation rule that checks if a value is the same as the given previous value.
+// It is useful for validating an update against the persisted entity, e.g.
+//
+//	valid.Field(&user.ID, valid.Immutable(old.ID))
+//
+// The values are compared with reflect.DeepEqual, except that two time.Time values are compared with time.Time.Equal
+// so that the same instant in a different location is not considered a change.
+// Unlike most other rules, an empty value is NOT skipped: clearing a value that was set is a change.
+func Immutable(old interface{}) ImmutableRule {
+	return ImmutableRule{
+		old: old,
+		err: ErrImmutable,
+	}
+}
+
+// ImmutableRule is a validation rule that checks if a value is the same as its previous version.
+type ImmutableRule struct {
+	old interface{}
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r ImmutableRule) Validate(value interface{}) error {
+	if t1, ok := value.(time.Time); ok {
+		if t2, ok := r.old.(time.Time); ok && t1.Equal(t2) {
+			return nil
+		}
+		return r.err
+	}
+	if !reflect.DeepEqual(value, r.old) {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r ImmutableRule) Error(message string) ImmutableRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ImmutableRule) ErrorObject(err Error) ImmutableRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,74 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmutable(t *testing.T) {
+	s1, s2 := "abc", "abc"
+	now := time.Now()
+	tests := []struct {
+		tag   string
+		old   interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", 1, 1, ""},
+		{"t2", 1, 2, "cannot be changed"},
+		{"t3", "abc", "abc", ""},
+		{"t4", "abc", "", "cannot be changed"},
+		{"t5", "", "", ""},
+		{"t6", nil, nil, ""},
+		{"t7", []int{1, 2}, []int{1, 2}, ""},
+		{"t8", []int{1, 2}, []int{2, 1}, "cannot be changed"},
+		{"t9", &s1, &s2, ""},
+		{"t10", now, now.UTC(), ""},
+		{"t11", now, now.Add(time.Second), "cannot be changed"},
+		{"t12", now, "abc", "cannot be changed"},
+		{"t13", 1, int64(1), "cannot be changed"},
+	}
+
+	for _, test := range tests {
+		err := Immutable(test.old).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestImmutable_Struct(t *testing.T) {
+	type entity struct {
+		ID   int
+		Name string
+	}
+	old := entity{ID: 1, Name: "a"}
+	e := entity{ID: 2, Name: "b"}
+	err := ValidateStruct(&e,
+		Field(&e.ID, Immutable(old.ID)),
+		Field(&e.Name, Required, Immutable(old.Name)),
+	)
+	assert.EqualError(t, err, "ID: cannot be changed; Name: cannot be changed.")
+}
+
+func TestImmutableRule_Error(t *testing.T) {
+	r := Immutable(1)
+	assert.Equal(t, "cannot be changed", r.Validate(2).Error())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestImmutableRule_ErrorObject(t *testing.T) {
+	r := Immutable(1)
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
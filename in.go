@@ -5,27 +5,76 @@
 package valid
 
 import (
+	"fmt"
+	"math"
 	"reflect"
+	"strings"
 )
 
-// ErrInInvalid is the error that returns in case of an invalid value for "in" rule.
-var ErrInInvalid = NewError("validation_in_invalid", "must be a valid value")
+// minInSetSize is the number of values from which In builds a set to look up values in constant time.
+// Smaller lists are scanned, which is faster than building a set for them.
+const minInSetSize = 16
+
+var (
+	// ErrInInvalid is the error that returns in case of an invalid value for "in" rule.
+	ErrInInvalid = NewError("validation_in_invalid", "must be a valid value")
+	// ErrInOneOf is the error that returns in case of an invalid value for "in" rule whose values all implement fmt.Stringer.
+	ErrInOneOf = NewError("validation_in_one_of", "must be one of: {{.values}}")
+)
 
 // In returns a validation rule that checks if a value can be found in the given list of values.
 // reflect.DeepEqual() will be used to determine if two values are equal.
 // For more details please refer to https://golang.org/pkg/reflect/#DeepEqual
+// Numbers of different predeclared int, uint and float types are considered equal if they have the same value,
+// so In(1, 2, 3) accepts an int32 or uint8 value of 1. Numbers of defined types (e.g. enum types) still
+// only match values of the same type.
+// If all values implement fmt.Stringer (e.g. named enum constants), the error message will list
+// them by their String() representations. The comparison is still performed on the values themselves.
+// Either way, the "values" parameter of the error is set to the values joined by ", ", e.g. for rendering the message
+// on a client.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+//
+// For a long list of values, the values of comparable types (e.g. strings, integers and structs of them)
+// are put in a set when the rule is created, so that repeated validations do not scan the whole list.
 func In(values ...interface{}) InRule {
-	return InRule{
+	r := InRule{
 		elements: values,
-		err:      ErrInInvalid,
+		err:      buildInRuleError(values),
 	}
+	r.set, r.others = buildInSet(values, nil)
+	return r
 }
 
 // InRule is a validation rule that validates if a value can be found in the given list of values.
 type InRule struct {
-	elements []interface{}
-	err      Error
+	elements            []interface{}
+	set                 map[interface{}]struct{}
+	others              []interface{}
+	transform           func(interface{}) interface{}
+	transformCandidates bool
+	err                 Error
+}
+
+// Using sets a transformation that normalizes the value (e.g. trimming spaces or lower-casing a string)
+// before it is looked up in the list of values. The value being validated is not modified.
+// Call NormalizeCandidates() to apply the same transformation to the list of values as well.
+func (r InRule) Using(transform func(interface{}) interface{}) InRule {
+	r.transform = transform
+	if r.transformCandidates {
+		r.set, r.others = buildInSet(r.elements, transform)
+	}
+	return r
+}
+
+// NormalizeCandidates configures the rule to also apply the transformation set by Using() to the list of values
+// before comparing them with the value. If the values are put in a set, they are transformed once
+// when the set is built.
+func (r InRule) NormalizeCandidates() InRule {
+	r.transformCandidates = true
+	if r.transform != nil {
+		r.set, r.others = buildInSet(r.elements, r.transform)
+	}
+	return r
 }
 
 // Validate checks if the given value is valid or not.
@@ -35,15 +84,43 @@ func (r InRule) Validate(value interface{}) error {
 		return nil
 	}
 
-	for _, e := range r.elements {
-		if reflect.DeepEqual(e, value) {
-			return nil
-		}
+	if r.transform != nil {
+		value = r.transform(value)
+	}
+	if r.contains(value) {
+		return nil
 	}
 
 	return r.err
 }
 
+// contains checks if the value is equal to one of the values of the rule.
+func (r InRule) contains(value interface{}) bool {
+	if r.set != nil {
+		if key, ok := inSetKey(value); ok {
+			if _, found := r.set[key]; found {
+				return true
+			}
+			// the value may still be equal to a value that is not in the set, e.g. a float or a big number
+			return r.scan(r.others, value)
+		}
+	}
+	return r.scan(r.elements, value)
+}
+
+// scan checks if the value is equal to one of the given values one by one.
+func (r InRule) scan(elements []interface{}, value interface{}) bool {
+	for _, e := range elements {
+		if r.transform != nil && r.transformCandidates {
+			e = r.transform(e)
+		}
+		if reflect.DeepEqual(e, value) || numbersEqual(e, value) {
+			return true
+		}
+	}
+	return false
+}
+
 // Error sets the error message for the rule.
 func (r InRule) Error(message string) InRule {
 	r.err = r.err.SetMessage(message)
@@ -55,3 +132,96 @@ func (r InRule) ErrorObject(err Error) InRule {
 	r.err = err
 	return r
 }
+
+func buildInRuleError(values []interface{}) Error {
+	if len(values) == 0 {
+		return ErrInInvalid
+	}
+	stringers := true
+	for _, v := range values {
+		_, ok := v.(fmt.Stringer)
+		stringers = stringers && ok
+	}
+	params := map[string]interface{}{"values": joinValues(values)}
+	if !stringers {
+		return ErrInInvalid.SetParams(params)
+	}
+	return ErrInOneOf.SetParams(params)
+}
+
+// joinValues returns the values formatted with fmt.Sprint and joined by ", ", as set to the "values" parameter
+// of the errors of In and NotIn.
+func joinValues(values []interface{}) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = fmt.Sprint(v)
+	}
+	return strings.Join(names, ", ")
+}
+
+// buildInSet puts the values that can be looked up by inSetKey, after applying the transformation if it is not nil,
+// in a set and returns it together with the rest of the values. A nil set is returned for a short list of values.
+func buildInSet(values []interface{}, transform func(interface{}) interface{}) (map[interface{}]struct{}, []interface{}) {
+	if len(values) < minInSetSize {
+		return nil, nil
+	}
+	set := make(map[interface{}]struct{}, len(values))
+	var others []interface{}
+	for _, v := range values {
+		e := v
+		if transform != nil {
+			e = transform(e)
+		}
+		if key, ok := inSetKey(e); ok {
+			set[key] = struct{}{}
+		} else {
+			others = append(others, v)
+		}
+	}
+	return set, others
+}
+
+// inSetKey returns the key of a value in the set built by buildInSet. The boolean result is false if the equality
+// of the value cannot be determined by the == operator in the same way as by reflect.DeepEqual and numbersEqual.
+// Integers of predeclared types are converted to int64, or to uint64 if they are too large, so that they are equal
+// to each other regardless of their types. Floats are not put in the set as they are compared with integers
+// after converting them to float64.
+func inSetKey(value interface{}) (interface{}, bool) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.Type().PkgPath() == "" {
+		switch numberKind(v.Kind()) {
+		case reflect.Int64:
+			return v.Int(), true
+		case reflect.Uint64:
+			if v.Uint() > math.MaxInt64 {
+				return v.Uint(), true
+			}
+			return int64(v.Uint()), true
+		case reflect.Float64:
+			return nil, false
+		}
+	}
+	return value, isPlainComparable(v.Type())
+}
+
+// isPlainComparable checks if the values of a type are compared by the == operator in the same way as by
+// reflect.DeepEqual, which is the case for the basic types and the arrays and structs that only consist of them.
+func isPlainComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Array:
+		return isPlainComparable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isPlainComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return numberKind(t.Kind()) != reflect.Invalid
+}
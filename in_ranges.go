@@ -0,0 +1,72 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import "strings"
+
+// ErrNotInRanges is the error that returns when a value is not within any of the specified ranges.
+var ErrNotInRanges = NewError("validation_not_in_ranges", "must be in one of the ranges: {{.ranges}}")
+
+// InRanges returns a validation rule that checks if a value is within at least one of the given ranges.
+// It is the counterpart of In for ordered values that are allowed in bands rather than as discrete values,
+// such as HTTP status codes or ports. For example,
+//
+//	valid.InRanges(valid.Range(200, 299), valid.Range(400, 499))
+//
+// The ranges are built with Range, so the same value types are supported, and the value must be of the
+// same type as the range bounds. The ranges are listed in the error message as intervals whose brackets tell
+// inclusive bounds from exclusive ones, e.g. "[-10, -5], (0, 5)". InRanges panics if no range is given.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InRanges(ranges ...RangeRule) InRangesRule {
+	if len(ranges) == 0 {
+		panic("valid: InRanges requires at least one range")
+	}
+	bands := make([]string, len(ranges))
+	for i, r := range ranges {
+		bands[i] = r.interval()
+	}
+	return InRangesRule{
+		ranges: ranges,
+		err:    ErrNotInRanges.SetParams(map[string]interface{}{"ranges": strings.Join(bands, ", ")}),
+	}
+}
+
+// InRangesRule is a validation rule that checks if a value is within any of the specified ranges.
+type InRangesRule struct {
+	ranges []RangeRule
+	err    Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r InRangesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	for _, rr := range r.ranges {
+		err := rr.Validate(value)
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(Error); !ok {
+			// the value cannot be compared with the range bounds
+			return err
+		}
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r InRangesRule) Error(message string) InRangesRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r InRangesRule) ErrorObject(err Error) InRangesRule {
+	r.err = err
+	return r
+}
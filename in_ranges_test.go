@@ -0,0 +1,67 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInRanges(t *testing.T) {
+	status := InRanges(Range(200, 299), Range(400, 499))
+	v := 404
+	var nilPtr *int
+	tests := []struct {
+		tag   string
+		rule  InRangesRule
+		value interface{}
+		err   string
+	}{
+		{"t1", status, 200, ""},
+		{"t2", status, 299, ""},
+		{"t3", status, 450, ""},
+		{"t4", status, 0, ""},
+		{"t5", status, &v, ""},
+		{"t6", status, nilPtr, ""},
+		{"t7", status, 199, "must be in one of the ranges: [200, 299], [400, 499]"},
+		{"t8", status, 302, "must be in one of the ranges: [200, 299], [400, 499]"},
+		{"t9", status, 500, "must be in one of the ranges: [200, 299], [400, 499]"},
+		{"t10", status, "abc", "cannot convert string to int64"},
+		{"t11", InRanges(Range(uint16(1024), uint16(49151))), uint16(8080), ""},
+		{"t12", InRanges(Range(uint16(1024), uint16(49151))), uint16(80), "must be in one of the ranges: [1024, 49151]"},
+		{"t13", InRanges(Range(0.5, 1.5)), 2.5, "must be in one of the ranges: [0.5, 1.5]"},
+		{"t14", InRanges(Range(-10, -5), Range(-3, 2)), -4, "must be in one of the ranges: [-10, -5], [-3, 2]"},
+		{"t15", InRanges(Range(-10, -5), Range(-3, 2)), -5, ""},
+		{"t16", InRanges(Range(1, 5).ExclusiveMax()), 5, "must be in one of the ranges: [1, 5)"},
+		{"t17", InRanges(Range(1, 5).ExclusiveMin()), 1, "must be in one of the ranges: (1, 5]"},
+		{"t18", InRanges(Range(1, 5).ExclusiveMin().ExclusiveMax(), Range(1, 5)), 5, ""},
+		{"t19", InRanges(Range(1, 5).ExclusiveMin().ExclusiveMax()), 5, "must be in one of the ranges: (1, 5)"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := status.Validate(302)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_not_in_ranges", err.(Error).Code())
+		assert.Equal(t, "[200, 299], [400, 499]", err.(Error).Params()["ranges"])
+	}
+}
+
+func TestInRanges_Empty(t *testing.T) {
+	assert.PanicsWithValue(t, "valid: InRanges requires at least one range", func() { InRanges() })
+}
+
+func TestInRangesRule_Error(t *testing.T) {
+	r := InRanges(Range(1, 10)).Error("out of band")
+	assert.Equal(t, "out of band", r.Validate(11).Error())
+
+	errObj := NewError("code", "abc")
+	r = r.ErrorObject(errObj)
+	assert.Equal(t, errObj, r.err)
+}
@@ -5,6 +5,10 @@
 package valid
 
 import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,6 +41,175 @@ func TestIn(t *testing.T) {
 	}
 }
 
+type status int
+
+const (
+	statusActive status = iota + 1
+	statusInactive
+)
+
+func (s status) String() string {
+	switch s {
+	case statusActive:
+		return "Active"
+	case statusInactive:
+		return "Inactive"
+	}
+	return "Unknown"
+}
+
+func TestIn_Stringer(t *testing.T) {
+	r := In(statusActive, statusInactive)
+	assert.Nil(t, r.Validate(statusActive))
+	assert.Nil(t, r.Validate(statusInactive))
+	assertError(t, "must be one of: Active, Inactive", r.Validate(status(3)), "t1")
+	// values are compared by type and value, not by their string representations
+	assertError(t, "must be one of: Active, Inactive", r.Validate(1), "t2")
+	assertError(t, "abc", r.Error("abc").Validate(status(3)), "t3")
+
+	// the default message is used unless all values are stringers
+	r = In(statusActive, 2)
+	assertError(t, "must be a valid value", r.Validate(status(3)), "t4")
+}
+
+func TestIn_Using(t *testing.T) {
+	normalize := func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			return strings.ToLower(strings.TrimSpace(s))
+		}
+		return value
+	}
+	tests := []struct {
+		tag   string
+		rule  InRule
+		value interface{}
+		err   string
+	}{
+		{"t1", In("red", "green").Using(normalize), " Red ", ""},
+		{"t2", In("red", "green").Using(normalize), "GREEN", ""},
+		{"t3", In("red", "green").Using(normalize), "blue", "must be a valid value"},
+		{"t4", In("Red", "Green").Using(normalize), "red", "must be a valid value"},
+		{"t5", In("Red", "Green").Using(normalize).NormalizeCandidates(), "red", ""},
+		{"t6", In("Red", "Green").Using(normalize).NormalizeCandidates(), " GREEN", ""},
+		{"t7", In("red").Using(normalize), "", ""},
+		{"t8", In(1, 2).Using(normalize), 2, ""},
+		{"t9", In("Red").NormalizeCandidates(), "red", "must be a valid value"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	value := " Red "
+	assert.Nil(t, In("red").Using(normalize).Validate(&value))
+	assert.Equal(t, " Red ", value)
+}
+
+func TestIn_NumericKinds(t *testing.T) {
+	r := In(1, 2, 3)
+	values := []interface{}{
+		int(2), int8(2), int16(2), int32(2), int64(2),
+		uint(2), uint8(2), uint16(2), uint32(2), uint64(2), uintptr(2),
+		float32(2), float64(2),
+	}
+	for _, value := range values {
+		assert.Nil(t, r.Validate(value), "%T", value)
+		assertError(t, "must not be in list", NotIn(1, 2, 3).Validate(value), fmt.Sprintf("%T", value))
+	}
+	assertError(t, "must be a valid value", r.Validate(int8(4)), "t1")
+	assertError(t, "must be a valid value", r.Validate(2.5), "t2")
+	assertError(t, "must be a valid value", r.Validate("2"), "t3")
+}
+
+func TestIn_BigNumbers(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	r := In(huge, big.NewRat(1, 2), 3)
+	assert.Nil(t, r.Validate(new(big.Int).Set(huge)))
+	assert.Nil(t, r.Validate(0.5))
+	assert.Nil(t, r.Validate(big.NewFloat(0.5)))
+	assert.Nil(t, r.Validate(big.NewInt(3)))
+	assertError(t, "must be a valid value", r.Validate(new(big.Int).Add(huge, big.NewInt(1))), "t1")
+	assertError(t, "must be a valid value", r.Validate("0.5"), "t2")
+	assertError(t, "must not be in list", NotIn(huge).Validate(new(big.Int).Set(huge)), "t3")
+}
+
+func TestIn_Set(t *testing.T) {
+	type point struct{ X, Y int }
+	huge := uint64(math.MaxUint64)
+	values := []interface{}{huge, 2.5, big.NewInt(-7), []byte("abc"), point{1, 2}, statusActive, "sku-1000"}
+	for i := 0; i < 100; i++ {
+		values = append(values, i, fmt.Sprintf("sku-%v", i))
+	}
+	r := In(values...)
+	assert.NotNil(t, r.set)
+	assert.Len(t, r.others, 3)
+	assert.Nil(t, In(1, 2, 3).set)
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "sku-99", ""},
+		{"t2", "sku-100", "must be a valid value"},
+		{"t3", "sku-1000", ""},
+		{"t4", 42, ""},
+		{"t5", int8(42), ""},
+		{"t6", uint16(42), ""},
+		{"t7", 42.0, ""},
+		{"t8", 100, "must be a valid value"},
+		{"t9", huge, ""},
+		{"t10", float32(2.5), ""},
+		{"t11", int64(-7), ""},
+		{"t12", big.NewInt(42), ""},
+		{"t13", []byte("abc"), ""},
+		{"t14", point{1, 2}, ""},
+		{"t15", point{2, 1}, "must be a valid value"},
+		{"t16", statusActive, ""},
+		{"t17", statusInactive, "must be a valid value"},
+		{"t18", status(2), "must be a valid value"},
+		{"t19", "", ""},
+	}
+	for _, test := range tests {
+		assertError(t, test.err, r.Validate(test.value), test.tag)
+	}
+
+	// the transformed values are put in the set in either order of Using and NormalizeCandidates
+	normalize := func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			return strings.ToLower(s)
+		}
+		return value
+	}
+	upper := make([]interface{}, 100)
+	for i := range upper {
+		upper[i] = fmt.Sprintf("SKU-%v", i)
+	}
+	assertError(t, "must be a valid value", In(upper...).Using(normalize).Validate("sku-1"), "t20")
+	assert.Nil(t, In(upper...).Using(normalize).NormalizeCandidates().Validate("Sku-1"))
+	assert.Nil(t, In(upper...).NormalizeCandidates().Using(normalize).Validate("sku-1"))
+}
+
+func BenchmarkIn(b *testing.B) {
+	values := make([]interface{}, 10000)
+	for i := range values {
+		values[i] = fmt.Sprintf("sku-%v", i)
+	}
+	b.Run("set", func(b *testing.B) {
+		r := In(values...)
+		for i := 0; i < b.N; i++ {
+			_ = r.Validate("sku-9999")
+		}
+	})
+	b.Run("scan", func(b *testing.B) {
+		r := InRule{elements: values, err: ErrInInvalid}
+		for i := 0; i < b.N; i++ {
+			_ = r.Validate("sku-9999")
+		}
+	})
+}
+
 func Test_InRule_Error(t *testing.T) {
 	r := In(1, 2, 3)
 	val := 4
@@ -55,3 +228,15 @@ func TestInRule_ErrorObject(t *testing.T) {
 	assert.Equal(t, err.Code(), r.err.Code())
 	assert.Equal(t, err.Message(), r.err.Message())
 }
+
+func TestIn_Params(t *testing.T) {
+	err := In("a", "b").Validate("c")
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"values": "a, b"}, err.(Error).Params())
+		assert.Equal(t, "must be a valid value", err.Error())
+	}
+	err = In(statusActive, statusInactive).Validate(status(3))
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"values": "Active, Inactive"}, err.(Error).Params())
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrCurrencyAmount is the error that returns in case of a malformed monetary amount.
+	ErrCurrencyAmount = valid.NewError("validation_is_currency_amount", "must be a valid amount")
+	// ErrCurrencyAmountPrecision is the error that returns in case of a monetary amount with too many decimal places.
+	ErrCurrencyAmountPrecision = valid.NewError("validation_is_currency_amount_precision", "must have no more than {{.decimals}} decimal places")
+)
+
+// currencyDecimals lists the ISO 4217 currencies whose minor unit is not 1/100 of the major unit.
+var currencyDecimals = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyAmount returns a validation rule that checks if a string is a monetary amount in the given
+// ISO 4217 currency, such as "1234.56", "1,234.56" or "-5" for USD. The number of decimal places must not exceed
+// the minor units of the currency: no decimals for JPY and KRW, three for KWD and BHD, and two for the other
+// currencies. Digits may be grouped by thousands, but then all groups must be separated. By default, "," separates
+// the groups and "." the decimals; call Separators() to change them (e.g. for "1.234,56").
+func CurrencyAmount(currency string) CurrencyAmountRule {
+	decimals, ok := currencyDecimals[strings.ToUpper(currency)]
+	if !ok {
+		decimals = 2
+	}
+	return CurrencyAmountRule{
+		decimals:     decimals,
+		re:           amountRegexp(',', '.'),
+		err:          ErrCurrencyAmount,
+		precisionErr: ErrCurrencyAmountPrecision.SetParams(map[string]interface{}{"decimals": decimals}),
+	}
+}
+
+// CurrencyAmountRule is a validation rule that checks if a string is a valid monetary amount.
+type CurrencyAmountRule struct {
+	decimals     int
+	re           *regexp.Regexp
+	err          valid.Error
+	precisionErr valid.Error
+}
+
+// Separators sets the characters separating the thousands groups and the decimals, respectively.
+func (r CurrencyAmountRule) Separators(group, decimal rune) CurrencyAmountRule {
+	r.re = amountRegexp(group, decimal)
+	return r
+}
+
+// Error sets the error message returned when the amount is malformed.
+func (r CurrencyAmountRule) Error(message string) CurrencyAmountRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct returned when the amount is malformed.
+func (r CurrencyAmountRule) ErrorObject(err valid.Error) CurrencyAmountRule {
+	r.err = err
+	return r
+}
+
+// PrecisionError sets the error message returned when the amount has too many decimal places.
+func (r CurrencyAmountRule) PrecisionError(message string) CurrencyAmountRule {
+	r.precisionErr = r.precisionErr.SetMessage(message)
+	return r
+}
+
+// PrecisionErrorObject sets the error struct returned when the amount has too many decimal places.
+func (r CurrencyAmountRule) PrecisionErrorObject(err valid.Error) CurrencyAmountRule {
+	r.precisionErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r CurrencyAmountRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	m := r.re.FindStringSubmatch(str)
+	if m == nil {
+		return r.err
+	}
+	if len(m[1]) > r.decimals {
+		return r.precisionErr
+	}
+	return nil
+}
+
+// amountRegexp returns the regular expression matching an amount with the given separators.
+// The decimals of the amount are captured by the first group.
+func amountRegexp(group, decimal rune) *regexp.Regexp {
+	g, d := regexp.QuoteMeta(string(group)), regexp.QuoteMeta(string(decimal))
+	return regexp.MustCompile(fmt.Sprintf(`^-?(?:\d+|\d{1,3}(?:%v\d{3})+)(?:%v(\d+))?$`, g, d))
+}
@@ -0,0 +1,56 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyAmount(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  CurrencyAmountRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", CurrencyAmount("USD"), "", ""},
+		{"t1.2", CurrencyAmount("USD"), "1234.56", ""},
+		{"t1.3", CurrencyAmount("USD"), "1,234.56", ""},
+		{"t1.4", CurrencyAmount("USD"), "1,234,567", ""},
+		{"t1.5", CurrencyAmount("usd"), "-5", ""},
+		{"t1.6", CurrencyAmount("USD"), "0.5", ""},
+		{"t1.7", CurrencyAmount("USD"), []byte("10.00"), ""},
+		{"t1.8", CurrencyAmount("USD"), "1234.567", "must have no more than 2 decimal places"},
+		{"t1.9", CurrencyAmount("USD"), "12,34.56", "must be a valid amount"},
+		{"t1.10", CurrencyAmount("USD"), "1,234567", "must be a valid amount"},
+		{"t1.11", CurrencyAmount("USD"), "1234.", "must be a valid amount"},
+		{"t1.12", CurrencyAmount("USD"), ".5", "must be a valid amount"},
+		{"t1.13", CurrencyAmount("USD"), "$12", "must be a valid amount"},
+		{"t1.14", CurrencyAmount("USD"), "1 234", "must be a valid amount"},
+		{"t1.15", CurrencyAmount("USD"), 12, "must be either a string or byte slice"},
+		{"t2.1", CurrencyAmount("JPY"), "1,000", ""},
+		{"t2.2", CurrencyAmount("JPY"), "1000.5", "must have no more than 0 decimal places"},
+		{"t3.1", CurrencyAmount("KWD"), "1.234", ""},
+		{"t3.2", CurrencyAmount("KWD"), "1.2345", "must have no more than 3 decimal places"},
+		{"t4.1", CurrencyAmount("EUR").Separators('.', ','), "1.234,56", ""},
+		{"t4.2", CurrencyAmount("EUR").Separators('.', ','), "1234,5", ""},
+		{"t4.3", CurrencyAmount("EUR").Separators('.', ','), "1,234.56", "must be a valid amount"},
+		{"t4.4", CurrencyAmount("CHF").Separators('\'', '.'), "1'234.50", ""},
+		{"t4.5", CurrencyAmount("EUR").Separators(' ', ','), "1 234,56", ""},
+		{"t5.1", CurrencyAmount("XYZ"), "1.23", ""},
+		{"t5.2", CurrencyAmount("XYZ"), "1.234", "must have no more than 2 decimal places"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := CurrencyAmount("JPY").Error("bad amount").PrecisionError("yen has no decimals")
+	assert.EqualError(t, r.Validate("abc"), "bad amount")
+	assert.EqualError(t, r.Validate("1.5"), "yen has no decimals")
+}
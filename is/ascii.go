@@ -0,0 +1,60 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import "github.com/maksliu/valid"
+
+// PrintableASCII validates if a string contains printable ASCII characters only.
+// Call Allow() to accept additional characters, e.g. PrintableASCII.Allow('\n', '\t') for multiline text.
+var PrintableASCII = PrintableASCIIRule{err: ErrPrintableASCII}
+
+// PrintableASCIIRule is a validation rule that checks if a string contains printable ASCII characters only.
+type PrintableASCIIRule struct {
+	allowed []rune
+	err     valid.Error
+}
+
+// Allow returns a rule that also accepts the given characters (typically control characters such as '\n' and '\t').
+func (r PrintableASCIIRule) Allow(chars ...rune) PrintableASCIIRule {
+	r.allowed = append(append([]rune{}, r.allowed...), chars...)
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r PrintableASCIIRule) Error(message string) PrintableASCIIRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PrintableASCIIRule) ErrorObject(err valid.Error) PrintableASCIIRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r PrintableASCIIRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	for _, c := range str {
+		if c >= 0x20 && c <= 0x7e || r.isAllowed(c) {
+			continue
+		}
+		return r.err
+	}
+	return nil
+}
+
+func (r PrintableASCIIRule) isAllowed(c rune) bool {
+	for _, a := range r.allowed {
+		if a == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintableASCII(t *testing.T) {
+	multiline := PrintableASCII.Allow('\n', '\t')
+	tests := []struct {
+		tag   string
+		rule  PrintableASCIIRule
+		value string
+		err   string
+	}{
+		{"t1", PrintableASCII, "", ""},
+		{"t2", PrintableASCII, "Hello, world!", ""},
+		{"t3", PrintableASCII, "line1\nline2", "must contain printable ASCII characters only"},
+		{"t4", PrintableASCII, "ａabc", "must contain printable ASCII characters only"},
+		{"t5", multiline, "line1\n\tline2", ""},
+		{"t6", multiline, "line1\r\nline2", "must contain printable ASCII characters only"},
+		{"t7", multiline, "bell\a", "must contain printable ASCII characters only"},
+		{"t8", multiline, "héllo", "must contain printable ASCII characters only"},
+		{"t9", multiline.Allow('\r'), "line1\r\nline2", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// Allow does not modify the rule it is called on
+	assert.NotNil(t, multiline.Validate("\r"))
+	assert.EqualError(t, PrintableASCII.Error("ascii only").Validate("\n"), "ascii only")
+}
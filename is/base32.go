@@ -0,0 +1,96 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"encoding/base32"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+// ErrBase32 is the error that returns in case of an invalid Base32 value.
+var ErrBase32 = valid.NewError("validation_is_base32", "must be encoded in Base32")
+
+var (
+	// Base32 validates if a string is encoded in the standard Base32 of RFC 4648 (e.g. TOTP seeds).
+	// The value must use the upper-case alphabet and be padded with "=" to a multiple of 8 characters.
+	// Call NoPadding() to validate unpadded values instead, or Crockford() to validate Crockford's Base32.
+	Base32 = Base32Rule{err: ErrBase32}
+	// Base32Crockford validates if a string is encoded in Crockford's Base32.
+	Base32Crockford = Base32.Crockford()
+)
+
+var (
+	crockfordEncoding = base32.NewEncoding("0123456789ABCDEFGHJKMNPQRSTVWXYZ").WithPadding(base32.NoPadding)
+	// crockfordReplacer normalizes the lower-case letters and the letters that are decoded as digits,
+	// and strips the hyphens that may be used to make the encoded value easier to read.
+	crockfordReplacer = strings.NewReplacer("I", "1", "i", "1", "L", "1", "l", "1", "O", "0", "o", "0", "-", "")
+)
+
+// Base32Rule is a validation rule that checks if a string is encoded in Base32.
+type Base32Rule struct {
+	noPadding bool
+	crockford bool
+	err       valid.Error
+}
+
+// NoPadding configures the rule to validate unpadded Base32, where the value must not end with "=".
+// It has no effect on Crockford's Base32, which is never padded.
+func (r Base32Rule) NoPadding() Base32Rule {
+	r.noPadding = true
+	return r
+}
+
+// Crockford configures the rule to validate Crockford's Base32, which is unpadded and case-insensitive.
+// The letters I and L are accepted as 1, O as 0, and hyphens are ignored.
+func (r Base32Rule) Crockford() Base32Rule {
+	r.crockford = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r Base32Rule) Error(message string) Base32Rule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r Base32Rule) ErrorObject(err valid.Error) Base32Rule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r Base32Rule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	encoding := base32.StdEncoding
+	switch {
+	case r.crockford:
+		encoding = crockfordEncoding
+		str = strings.ToUpper(crockfordReplacer.Replace(str))
+	case r.noPadding:
+		encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+	}
+	// the encodings ignore line breaks, which are not allowed in a single value
+	if strings.ContainsAny(str, "\r\n") {
+		return r.err
+	}
+	if encoding != base32.StdEncoding {
+		// an unpadded value must not end with a partial group of 1, 3 or 6 characters, which cannot hold a whole byte
+		switch len(str) % 8 {
+		case 1, 3, 6:
+			return r.err
+		}
+	}
+	if _, err := encoding.DecodeString(str); err != nil {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase32(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  Base32Rule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Base32, "", ""},
+		{"t1.2", Base32, "JBSWY3DPEHPK3PXP", ""},
+		{"t1.3", Base32, "MZXW6===", ""},
+		{"t1.4", Base32, []byte("MZXW6YQ="), ""},
+		{"t1.5", Base32, "MZXW6", "must be encoded in Base32"},
+		{"t1.6", Base32, "MZXW6==", "must be encoded in Base32"},
+		{"t1.7", Base32, "mzxw6===", "must be encoded in Base32"},
+		{"t1.8", Base32, "MZXW1===", "must be encoded in Base32"},
+		{"t1.9", Base32, "JBSWY3DP\nEHPK3PXP", "must be encoded in Base32"},
+		{"t1.10", Base32, 123, "must be either a string or byte slice"},
+		{"t2.1", Base32.NoPadding(), "JBSWY3DPEHPK3PXP", ""},
+		{"t2.2", Base32.NoPadding(), "MZXW6", ""},
+		{"t2.3", Base32.NoPadding(), "MZXW6===", "must be encoded in Base32"},
+		{"t2.4", Base32.NoPadding(), "MZX", "must be encoded in Base32"},
+		{"t3.1", Base32Crockford, "CSQPY", ""},
+		{"t3.2", Base32Crockford, "csqpy", ""},
+		{"t3.3", Base32Crockford, "CS-QPY", ""},
+		{"t3.4", Base32Crockford, "91JPRV3F", ""},
+		{"t3.5", Base32Crockford, "9IJPRV3F", ""},
+		{"t3.6", Base32Crockford, "CSQPU", "must be encoded in Base32"},
+		{"t3.7", Base32Crockford, "CSQPY===", "must be encoded in Base32"},
+		{"t3.8", Base32Crockford, "CSQ", "must be encoded in Base32"},
+		{"t3.9", Base32.NoPadding().Crockford(), "CSQPY", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, Base32.Error("not a TOTP seed").Validate("x"), "not a TOTP seed")
+}
@@ -0,0 +1,72 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"regexp"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	reHexColor      = regexp.MustCompile(`^#?(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	reHexColorAlpha = regexp.MustCompile(`^#?(?:[0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+)
+
+// HexColor validates if a string is a valid hexadecimal color code in the form of RGB or RRGGBB,
+// optionally prefixed with "#".
+// Call WithAlpha() to also accept the RGBA and RRGGBBAA forms and RequireHash() to make the "#" prefix mandatory.
+var HexColor = HexColorRule{err: ErrHexColor}
+
+// HexColorRule is a validation rule that checks if a string is a valid hexadecimal color code.
+type HexColorRule struct {
+	alpha       bool
+	requireHash bool
+	err         valid.Error
+}
+
+// WithAlpha configures the rule to also accept color codes with an alpha channel (#RGBA and #RRGGBBAA).
+func (r HexColorRule) WithAlpha() HexColorRule {
+	r.alpha = true
+	return r
+}
+
+// RequireHash configures the rule to only accept color codes prefixed with "#".
+func (r HexColorRule) RequireHash() HexColorRule {
+	r.requireHash = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r HexColorRule) Error(message string) HexColorRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r HexColorRule) ErrorObject(err valid.Error) HexColorRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r HexColorRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if r.requireHash && str[0] != '#' {
+		return r.err
+	}
+	re := reHexColor
+	if r.alpha {
+		re = reHexColorAlpha
+	}
+	if !re.MatchString(str) {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHexColor(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  HexColorRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", HexColor, "", ""},
+		{"t1.2", HexColor, "F00", ""},
+		{"t1.3", HexColor, "#ff0000", ""},
+		{"t1.4", HexColor, "FTF", "must be a valid hexadecimal color code"},
+		{"t1.5", HexColor, "#F00F", "must be a valid hexadecimal color code"},
+		{"t1.6", HexColor, "#FF0000FF", "must be a valid hexadecimal color code"},
+		{"t1.7", HexColor, []byte("#abc"), ""},
+		{"t1.8", HexColor, 123, "must be either a string or byte slice"},
+		{"t2.1", HexColor.WithAlpha(), "F00", ""},
+		{"t2.2", HexColor.WithAlpha(), "#F00F", ""},
+		{"t2.3", HexColor.WithAlpha(), "#FF000080", ""},
+		{"t2.4", HexColor.WithAlpha(), "#FF00008", "must be a valid hexadecimal color code"},
+		{"t2.5", HexColor.WithAlpha(), "#FF0000GG", "must be a valid hexadecimal color code"},
+		{"t3.1", HexColor.RequireHash(), "#F00", ""},
+		{"t3.2", HexColor.RequireHash(), "F00", "must be a valid hexadecimal color code"},
+		{"t3.3", HexColor.RequireHash().WithAlpha(), "#F00F", ""},
+		{"t3.4", HexColor.RequireHash().WithAlpha(), "F00F", "must be a valid hexadecimal color code"},
+		{"t3.5", HexColor.RequireHash(), "", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	s := "#F00"
+	assert.Nil(t, HexColor.Validate(&s))
+	assert.EqualError(t, HexColor.Error("bad color").Validate("xyz"), "bad color")
+}
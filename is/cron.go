@@ -0,0 +1,151 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrCron is the error that returns in case of a cron expression with a wrong number of fields.
+	ErrCron = valid.NewError("validation_is_cron", "must be a valid cron expression")
+	// ErrCronField is the error that returns in case of a cron expression with an invalid field.
+	ErrCronField = valid.NewError("validation_is_cron_field", "must be a valid cron expression (invalid {{.field}} field)")
+)
+
+// Cron validates if a string is a valid cron expression consisting of five fields:
+// minute, hour, day of month, month and day of week.
+// Each field accepts "*", values, ranges ("1-5"), steps ("*/15", "0-30/5") and comma-separated lists of them.
+// Months and days of week may also be specified by their English abbreviations (e.g. "JAN", "mon").
+// The predefined schedules "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight"
+// and "@hourly" are accepted as well. Call WithSeconds() to require a leading seconds field.
+var Cron = CronRule{err: ErrCron, fieldErr: ErrCronField}
+
+// CronRule is a validation rule that checks if a string is a valid cron expression.
+type CronRule struct {
+	seconds       bool
+	err, fieldErr valid.Error
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	cronSecondField = cronField{name: "second", min: 0, max: 59}
+	cronFields      = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: []string{"", "JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+		{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	}
+	cronDescriptors = map[string]bool{
+		"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true, "@daily": true, "@midnight": true, "@hourly": true,
+	}
+)
+
+// WithSeconds configures the rule to require six fields, the first of which specifies the seconds.
+func (r CronRule) WithSeconds() CronRule {
+	r.seconds = true
+	return r
+}
+
+// Error sets the error message that is used when the value being validated has a wrong number of fields.
+func (r CronRule) Error(message string) CronRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value being validated has a wrong number of fields.
+func (r CronRule) ErrorObject(err valid.Error) CronRule {
+	r.err = err
+	return r
+}
+
+// FieldError sets the error message that is used when a field of the value being validated is invalid.
+// The name of the invalid field is available as the "field" parameter of the message.
+func (r CronRule) FieldError(message string) CronRule {
+	r.fieldErr = r.fieldErr.SetMessage(message)
+	return r
+}
+
+// FieldErrorObject sets the error struct that is used when a field of the value being validated is invalid.
+func (r CronRule) FieldErrorObject(err valid.Error) CronRule {
+	r.fieldErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r CronRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if cronDescriptors[strings.ToLower(strings.TrimSpace(str))] {
+		return nil
+	}
+
+	fields := cronFields
+	if r.seconds {
+		fields = append([]cronField{cronSecondField}, cronFields...)
+	}
+	parts := strings.Fields(str)
+	if len(parts) != len(fields) {
+		return r.err
+	}
+	for i, part := range parts {
+		if !fields[i].check(part) {
+			return r.fieldErr.SetParams(map[string]interface{}{"field": fields[i].name})
+		}
+	}
+	return nil
+}
+
+// check checks if the given expression is valid for the cron field.
+func (f cronField) check(expr string) bool {
+	for _, item := range strings.Split(expr, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return false
+			}
+		}
+		if rng == "*" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(rng, "-")
+		from, ok := f.parse(lo)
+		if !ok {
+			return false
+		}
+		if isRange {
+			if to, ok := f.parse(hi); !ok || to < from {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// parse parses a single value of the cron field, which may be a number or a name.
+func (f cronField) parse(s string) (int, bool) {
+	for i, name := range f.names {
+		if name != "" && strings.EqualFold(s, name) {
+			return i, true
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max || s[0] == '+' || s[0] == '-' {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,49 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCron(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  CronRule
+		value string
+		err   string
+	}{
+		{"t1", Cron, "", ""},
+		{"t2", Cron, "* * * * *", ""},
+		{"t3", Cron, "*/15 0-6,18-23 1 JAN-jun mon-FRI", ""},
+		{"t4", Cron, "0 12 L * *", "must be a valid cron expression (invalid day of month field)"},
+		{"t5", Cron, "0 0 1 1 7", ""},
+		{"t6", Cron, "0 0 1 * 8", "must be a valid cron expression (invalid day of week field)"},
+		{"t7", Cron, "60 * * * *", "must be a valid cron expression (invalid minute field)"},
+		{"t8", Cron, "* 5-2 * * *", "must be a valid cron expression (invalid hour field)"},
+		{"t9", Cron, "*/0 * * * *", "must be a valid cron expression (invalid minute field)"},
+		{"t10", Cron, "* * 0 * *", "must be a valid cron expression (invalid day of month field)"},
+		{"t11", Cron, "* * * 13 *", "must be a valid cron expression (invalid month field)"},
+		{"t12", Cron, "1,,2 * * * *", "must be a valid cron expression (invalid minute field)"},
+		{"t13", Cron, "5/10 * * * *", ""},
+		{"t14", Cron, "* * * *", "must be a valid cron expression"},
+		{"t15", Cron, "0 * * * * *", "must be a valid cron expression"},
+		{"t16", Cron, "@daily", ""},
+		{"t17", Cron, "@often", "must be a valid cron expression"},
+		{"t18", Cron.WithSeconds(), "30 0 * * * *", ""},
+		{"t19", Cron.WithSeconds(), "61 0 * * * *", "must be a valid cron expression (invalid second field)"},
+		{"t20", Cron.WithSeconds(), "0 * * * *", "must be a valid cron expression"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, Cron.Error("bad").Validate("*"), "bad")
+	assert.EqualError(t, Cron.FieldError("bad {{.field}}").Validate("* 24 * * *"), "bad hour")
+}
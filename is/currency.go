@@ -0,0 +1,94 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+// CurrencyCode validates if a string is a valid ISO 4217 alphabetic currency code (e.g. USD).
+// Call AllowNumeric() to also accept the numeric codes (e.g. 840).
+var CurrencyCode = CurrencyCodeRule{err: ErrCurrencyCode}
+
+// currencyNumericCodes maps the ISO 4217 numeric currency codes to the alphabetic codes of govalidator.ISO4217List.
+var currencyNumericCodes = map[string]string{
+	"784": "AED", "971": "AFN", "008": "ALL", "051": "AMD", "532": "ANG", "973": "AOA", "032": "ARS", "036": "AUD",
+	"533": "AWG", "944": "AZN",
+	"977": "BAM", "052": "BBD", "050": "BDT", "975": "BGN", "048": "BHD", "108": "BIF", "060": "BMD", "096": "BND",
+	"068": "BOB", "984": "BOV", "986": "BRL", "044": "BSD", "064": "BTN", "072": "BWP", "933": "BYN", "084": "BZD",
+	"124": "CAD", "976": "CDF", "947": "CHE", "756": "CHF", "948": "CHW", "990": "CLF", "152": "CLP", "156": "CNY",
+	"170": "COP", "970": "COU", "188": "CRC", "931": "CUC", "192": "CUP", "132": "CVE", "203": "CZK",
+	"262": "DJF", "208": "DKK", "214": "DOP", "012": "DZD",
+	"818": "EGP", "232": "ERN", "230": "ETB", "978": "EUR",
+	"242": "FJD", "238": "FKP",
+	"826": "GBP", "981": "GEL", "936": "GHS", "292": "GIP", "270": "GMD", "324": "GNF", "320": "GTQ", "328": "GYD",
+	"344": "HKD", "340": "HNL", "191": "HRK", "332": "HTG", "348": "HUF",
+	"360": "IDR", "376": "ILS", "356": "INR", "368": "IQD", "364": "IRR", "352": "ISK",
+	"388": "JMD", "400": "JOD", "392": "JPY",
+	"404": "KES", "417": "KGS", "116": "KHR", "174": "KMF", "408": "KPW", "410": "KRW", "414": "KWD", "136": "KYD",
+	"398": "KZT",
+	"418": "LAK", "422": "LBP", "144": "LKR", "430": "LRD", "426": "LSL", "434": "LYD",
+	"504": "MAD", "498": "MDL", "969": "MGA", "807": "MKD", "104": "MMK", "496": "MNT", "446": "MOP", "478": "MRO",
+	"480": "MUR", "462": "MVR", "454": "MWK", "484": "MXN", "979": "MXV", "458": "MYR", "943": "MZN",
+	"516": "NAD", "566": "NGN", "558": "NIO", "578": "NOK", "524": "NPR", "554": "NZD",
+	"512": "OMR",
+	"590": "PAB", "604": "PEN", "598": "PGK", "608": "PHP", "586": "PKR", "985": "PLN", "600": "PYG",
+	"634": "QAR",
+	"946": "RON", "941": "RSD", "643": "RUB", "646": "RWF",
+	"682": "SAR", "090": "SBD", "690": "SCR", "938": "SDG", "752": "SEK", "702": "SGD", "654": "SHP", "694": "SLL",
+	"706": "SOS", "968": "SRD", "728": "SSP", "678": "STD", "930": "STN", "222": "SVC", "760": "SYP", "748": "SZL",
+	"764": "THB", "972": "TJS", "934": "TMT", "788": "TND", "776": "TOP", "949": "TRY", "780": "TTD", "901": "TWD",
+	"834": "TZS",
+	"980": "UAH", "800": "UGX", "840": "USD", "997": "USN", "940": "UYI", "858": "UYU", "927": "UYW", "860": "UZS",
+	"937": "VEF", "928": "VES", "704": "VND", "548": "VUV",
+	"882": "WST",
+	"950": "XAF", "961": "XAG", "959": "XAU", "955": "XBA", "956": "XBB", "957": "XBC", "958": "XBD", "951": "XCD",
+	"960": "XDR", "952": "XOF", "964": "XPD", "953": "XPF", "962": "XPT", "994": "XSU", "963": "XTS", "965": "XUA",
+	"999": "XXX",
+	"886": "YER",
+	"710": "ZAR", "967": "ZMW", "932": "ZWL",
+}
+
+// CurrencyCodeRule is a validation rule that checks if a string is a valid ISO 4217 currency code.
+type CurrencyCodeRule struct {
+	allowNumeric bool
+	err          valid.Error
+}
+
+// AllowNumeric configures the rule to accept the three-digit ISO 4217 numeric codes (e.g. 840 for USD, 008 for ALL)
+// in addition to the alphabetic codes. A numeric code must be listed in the ISO 4217 table.
+func (r CurrencyCodeRule) AllowNumeric() CurrencyCodeRule {
+	r.allowNumeric = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r CurrencyCodeRule) Error(message string) CurrencyCodeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r CurrencyCodeRule) ErrorObject(err valid.Error) CurrencyCodeRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r CurrencyCodeRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if govalidator.IsISO4217(str) {
+		return nil
+	}
+	if _, ok := currencyNumericCodes[str]; ok && r.allowNumeric {
+		return nil
+	}
+	return r.err
+}
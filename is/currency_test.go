@@ -0,0 +1,70 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyCode(t *testing.T) {
+	var empty *string
+	numeric := "840"
+	tests := []struct {
+		tag   string
+		rule  CurrencyCodeRule
+		value interface{}
+		err   string
+	}{
+		{"t1", CurrencyCode, "", ""},
+		{"t2", CurrencyCode, empty, ""},
+		{"t3", CurrencyCode, "USD", ""},
+		{"t4", CurrencyCode, []byte("EUR"), ""},
+		{"t5", CurrencyCode, "usd", "must be valid ISO 4217 currency code"},
+		{"t6", CurrencyCode, "USS", "must be valid ISO 4217 currency code"},
+		{"t7", CurrencyCode, "840", "must be valid ISO 4217 currency code"},
+		{"t8", CurrencyCode, 840, "must be either a string or byte slice"},
+		{"t9", CurrencyCode.AllowNumeric(), "840", ""},
+		{"t10", CurrencyCode.AllowNumeric(), "008", ""},
+		{"t11", CurrencyCode.AllowNumeric(), &numeric, ""},
+		{"t12", CurrencyCode.AllowNumeric(), "USD", ""},
+		{"t13", CurrencyCode.AllowNumeric(), "8", "must be valid ISO 4217 currency code"},
+		{"t14", CurrencyCode.AllowNumeric(), "000", "must be valid ISO 4217 currency code"},
+		{"t15", CurrencyCode.AllowNumeric(), "0840", "must be valid ISO 4217 currency code"},
+		{"t16", CurrencyCode.AllowNumeric(), " 840", "must be valid ISO 4217 currency code"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestCurrencyCodeRule_Error(t *testing.T) {
+	r := CurrencyCode.AllowNumeric().Error("abc")
+	assert.Equal(t, "abc", r.Validate("USS").Error())
+	assert.Nil(t, r.Validate("978"))
+
+	err := valid.NewError("code", "abc")
+	r = CurrencyCode.ErrorObject(err)
+	assert.Equal(t, err, r.Validate("USS"))
+}
+
+func TestCurrencyNumericCodes(t *testing.T) {
+	// every alphabetic code has exactly one numeric code
+	numeric := map[string]string{}
+	for code, alpha := range currencyNumericCodes {
+		assert.Len(t, code, 3, code)
+		assert.True(t, govalidator.IsISO4217(alpha), alpha)
+		assert.Empty(t, numeric[alpha], alpha)
+		numeric[alpha] = code
+	}
+	for _, alpha := range govalidator.ISO4217List {
+		assert.NotEmpty(t, numeric[alpha], alpha)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrDataURIImage is the error that returns in case of a value that is not a base64-encoded data URI.
+	ErrDataURIImage = valid.NewError("validation_is_data_uri_image", "must be a Base64-encoded image data URI")
+	// ErrDataURIImageType is the error that returns in case of a data URI whose media type is not an allowed image type.
+	ErrDataURIImageType = valid.NewError("validation_is_data_uri_image_type", "must be an image of type {{.types}}")
+	// ErrDataURIImageEncoding is the error that returns in case of a data URI whose data is not valid Base64.
+	ErrDataURIImageEncoding = valid.NewError("validation_is_data_uri_image_encoding", "must contain valid Base64-encoded data")
+	// ErrDataURIImageSize is the error that returns in case of a data URI whose decoded data is too large.
+	ErrDataURIImageSize = valid.NewError("validation_is_data_uri_image_size", "the image size must be no more than {{.max}} bytes")
+)
+
+// DataURIImage validates if a string is a Base64-encoded data URI of an image, such as an avatar upload sent as
+// "data:image/png;base64,iVBORw0KGgo...". The media type must be an image type (i.e. "image/*") and the data must
+// be valid Base64. Call Types() to only accept some image types and MaxBytes() to limit the size of the decoded data.
+// Each failure is reported with a distinct error. Note that the rule does not check if the data is actually an
+// image of the declared type.
+var DataURIImage = DataURIImageRule{
+	types:       []string{"image/*"},
+	err:         ErrDataURIImage,
+	typeErr:     ErrDataURIImageType.SetParams(map[string]interface{}{"types": "image/*"}),
+	encodingErr: ErrDataURIImageEncoding,
+	sizeErr:     ErrDataURIImageSize,
+}
+
+// DataURIImageRule is a validation rule that checks if a string is a Base64-encoded data URI of an image.
+type DataURIImageRule struct {
+	types       []string
+	max         int
+	err         valid.Error
+	typeErr     valid.Error
+	encodingErr valid.Error
+	sizeErr     valid.Error
+}
+
+// Types configures the rule to only accept the given media types, e.g. Types("image/png", "image/jpeg").
+// The comparison is case-insensitive, and a type may use "*" as its subtype as with MimeType.In().
+// The "types" parameter of the type error lists the given types.
+func (r DataURIImageRule) Types(types ...string) DataURIImageRule {
+	r.types = make([]string, len(types))
+	for i, t := range types {
+		r.types[i] = strings.ToLower(t)
+	}
+	r.typeErr = r.typeErr.SetParams(map[string]interface{}{"types": strings.Join(types, ", ")})
+	return r
+}
+
+// MaxBytes configures the rule to reject the data URIs whose decoded data is larger than n bytes.
+// A value of zero or less means there is no limit, which is the default.
+func (r DataURIImageRule) MaxBytes(n int) DataURIImageRule {
+	r.max = n
+	r.sizeErr = r.sizeErr.SetParams(map[string]interface{}{"max": n})
+	return r
+}
+
+// Error sets the error message returned when the value is not a Base64-encoded data URI.
+func (r DataURIImageRule) Error(message string) DataURIImageRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct returned when the value is not a Base64-encoded data URI.
+func (r DataURIImageRule) ErrorObject(err valid.Error) DataURIImageRule {
+	r.err = err
+	return r
+}
+
+// TypeError sets the error message returned when the media type is not allowed.
+func (r DataURIImageRule) TypeError(message string) DataURIImageRule {
+	r.typeErr = r.typeErr.SetMessage(message)
+	return r
+}
+
+// TypeErrorObject sets the error struct returned when the media type is not allowed.
+func (r DataURIImageRule) TypeErrorObject(err valid.Error) DataURIImageRule {
+	r.typeErr = err
+	return r
+}
+
+// EncodingError sets the error message returned when the data is not valid Base64.
+func (r DataURIImageRule) EncodingError(message string) DataURIImageRule {
+	r.encodingErr = r.encodingErr.SetMessage(message)
+	return r
+}
+
+// EncodingErrorObject sets the error struct returned when the data is not valid Base64.
+func (r DataURIImageRule) EncodingErrorObject(err valid.Error) DataURIImageRule {
+	r.encodingErr = err
+	return r
+}
+
+// SizeError sets the error message returned when the decoded data is too large.
+func (r DataURIImageRule) SizeError(message string) DataURIImageRule {
+	r.sizeErr = r.sizeErr.SetMessage(message)
+	return r
+}
+
+// SizeErrorObject sets the error struct returned when the decoded data is too large.
+func (r DataURIImageRule) SizeErrorObject(err valid.Error) DataURIImageRule {
+	r.sizeErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r DataURIImageRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	// data:[<media type>][;<parameter>]*;base64,<data>
+	if len(str) < len("data:") || !strings.EqualFold(str[:len("data:")], "data:") {
+		return r.err
+	}
+	meta, data, found := strings.Cut(str[len("data:"):], ",")
+	if !found || len(meta) < len(";base64") || !strings.EqualFold(meta[len(meta)-len(";base64"):], ";base64") {
+		return r.err
+	}
+	meta = meta[:len(meta)-len(";base64")]
+	if meta == "" {
+		// the media type defaults to text/plain as specified by RFC 2397
+		return r.typeErr
+	}
+	mediaType, _, err := mime.ParseMediaType(meta)
+	if err != nil {
+		return r.err
+	}
+	if !strings.HasPrefix(mediaType, "image/") || !matchMimeType(r.types, mediaType) {
+		return r.typeErr
+	}
+
+	// the data is decoded as a stream so that it is not held in memory again, and the decoding stops as soon as
+	// the size limit is exceeded
+	encoding := base64.StdEncoding
+	if !strings.HasSuffix(data, "=") && len(data)%4 != 0 {
+		encoding = base64.RawStdEncoding
+	}
+	var decoder io.Reader = base64.NewDecoder(encoding, strings.NewReader(data))
+	if r.max > 0 {
+		decoder = io.LimitReader(decoder, int64(r.max)+1)
+	}
+	n, err := io.Copy(io.Discard, decoder)
+	if err != nil || n == 0 {
+		return r.encodingErr
+	}
+	if r.max > 0 && n > int64(r.max) {
+		return r.sizeErr
+	}
+	return nil
+}
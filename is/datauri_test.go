@@ -0,0 +1,79 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataURIImage(t *testing.T) {
+	// the PNG signature followed by some data
+	png := base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n0123456789"))
+	large := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 2000)))
+
+	tests := []struct {
+		tag   string
+		rule  DataURIImageRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", DataURIImage, "", ""},
+		{"t1.2", DataURIImage, "data:image/png;base64," + png, ""},
+		{"t1.3", DataURIImage, "DATA:Image/PNG;BASE64," + png, ""},
+		{"t1.4", DataURIImage, "data:image/svg+xml;charset=utf-8;base64," + png, ""},
+		{"t1.5", DataURIImage, "data:image/gif;base64,R0lGODlhAQABAAAAACw", ""},
+		{"t1.6", DataURIImage, []byte("data:image/png;base64," + png), ""},
+		{"t2.1", DataURIImage, "image/png;base64," + png, "must be a Base64-encoded image data URI"},
+		{"t2.2", DataURIImage, "data:image/png," + png, "must be a Base64-encoded image data URI"},
+		{"t2.3", DataURIImage, "data:image/png;base64", "must be a Base64-encoded image data URI"},
+		{"t2.4", DataURIImage, "data:", "must be a Base64-encoded image data URI"},
+		{"t2.5", DataURIImage, "data:image/;base64," + png, "must be a Base64-encoded image data URI"},
+		{"t2.6", DataURIImage, 123, "must be either a string or byte slice"},
+		{"t3.1", DataURIImage, "data:;base64," + png, "must be an image of type image/*"},
+		{"t3.2", DataURIImage, "data:text/plain;base64," + png, "must be an image of type image/*"},
+		{"t3.3", DataURIImage.Types("image/png", "image/jpeg"), "data:image/jpeg;base64," + png, ""},
+		{"t3.4", DataURIImage.Types("image/png", "image/jpeg"), "data:image/gif;base64," + png, "must be an image of type image/png, image/jpeg"},
+		{"t3.5", DataURIImage.Types("IMAGE/PNG"), "data:image/png;base64," + png, ""},
+		{"t3.6", DataURIImage.Types("application/pdf"), "data:application/pdf;base64," + png, "must be an image of type application/pdf"},
+		{"t4.1", DataURIImage, "data:image/png;base64,", "must contain valid Base64-encoded data"},
+		{"t4.2", DataURIImage, "data:image/png;base64,not base64!", "must contain valid Base64-encoded data"},
+		{"t4.3", DataURIImage, "data:image/png;base64,abc=d", "must contain valid Base64-encoded data"},
+		{"t5.1", DataURIImage.MaxBytes(2000), "data:image/png;base64," + large, ""},
+		{"t5.2", DataURIImage.MaxBytes(1999), "data:image/png;base64," + large, "the image size must be no more than 1999 bytes"},
+		{"t5.3", DataURIImage.MaxBytes(0), "data:image/png;base64," + large, ""},
+		{"t5.4", DataURIImage.MaxBytes(10), "data:image/png;base64," + large + "!", "the image size must be no more than 10 bytes"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	codes := map[string]string{
+		"data:image/png," + png:          "validation_is_data_uri_image",
+		"data:text/plain;base64," + png:  "validation_is_data_uri_image_type",
+		"data:image/png;base64,!!!!":     "validation_is_data_uri_image_encoding",
+		"data:image/png;base64," + large: "validation_is_data_uri_image_size",
+	}
+	for value, code := range codes {
+		err := DataURIImage.MaxBytes(100).Validate(value)
+		if assert.NotNil(t, err, code) {
+			assert.Equal(t, code, err.(valid.Error).Code())
+		}
+	}
+
+	assert.EqualError(t, DataURIImage.Error("bad").Validate("abc"), "bad")
+	assert.EqualError(t, DataURIImage.TypeError("bad type").Validate("data:text/plain;base64,"+png), "bad type")
+	assert.EqualError(t, DataURIImage.EncodingError("bad data").Validate("data:image/png;base64,!"), "bad data")
+	assert.EqualError(t, DataURIImage.MaxBytes(1).SizeError("too big").Validate("data:image/png;base64,"+png), "too big")
+	err := DataURIImage.MaxBytes(1).SizeErrorObject(valid.NewError("size", "too big")).Validate("data:image/png;base64," + png)
+	assert.Equal(t, "size", err.(valid.Error).Code())
+	assert.Equal(t, 1, DataURIImage.MaxBytes(1).Validate("data:image/png;base64," + png).(valid.Error).Params()["max"])
+}
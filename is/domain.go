@@ -0,0 +1,65 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+	"golang.org/x/net/idna"
+)
+
+var (
+	// Domain validates if a string is valid domain.
+	// Call AllowUnicode() to also accept internationalized domain names (e.g. "münchen.de").
+	Domain = DomainRule{validate: isDomain, err: ErrDomain}
+	// DNSName validates if a string is valid DNS name.
+	// Call AllowUnicode() to also accept internationalized domain names (e.g. "münchen.de").
+	DNSName = DomainRule{validate: govalidator.IsDNSName, err: ErrDNSName}
+)
+
+// DomainRule is a validation rule that checks if a string is a valid domain name.
+type DomainRule struct {
+	validate func(string) bool
+	unicode  bool
+	err      valid.Error
+}
+
+// AllowUnicode configures the rule to accept internationalized domain names.
+// A domain name containing non-ASCII characters is converted into its punycode (ASCII) form
+// according to IDNA2008 before it is validated.
+func (r DomainRule) AllowUnicode() DomainRule {
+	r.unicode = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r DomainRule) Error(message string) DomainRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DomainRule) ErrorObject(err valid.Error) DomainRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r DomainRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if r.unicode {
+		if str, err = idna.Lookup.ToASCII(str); err != nil {
+			return r.err
+		}
+	}
+	if !r.validate(str) {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomain(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  DomainRule
+		value string
+		err   string
+	}{
+		{"t1.1", Domain, "", ""},
+		{"t1.2", Domain, "example.com", ""},
+		{"t1.3", Domain, "xn--mnchen-3ya.de", ""},
+		{"t1.4", Domain, "xn--e1afmkfd.xn--p1ai", ""},
+		{"t1.5", Domain, "münchen.de", "must be a valid domain"},
+		{"t2.1", Domain.AllowUnicode(), "münchen.de", ""},
+		{"t2.2", Domain.AllowUnicode(), "пример.рф", ""},
+		{"t2.3", Domain.AllowUnicode(), "example.com", ""},
+		{"t2.4", Domain.AllowUnicode(), "localhost", "must be a valid domain"},
+		{"t2.5", Domain.AllowUnicode(), "mün chen.de", "must be a valid domain"},
+		{"t3.1", DNSName, "münchen.de", "must be a valid DNS name"},
+		{"t3.2", DNSName.AllowUnicode(), "münchen.de", ""},
+		{"t3.3", DNSName.AllowUnicode(), "abc%", "must be a valid DNS name"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, Domain.Error("bad domain").Validate("localhost"), "bad domain")
+}
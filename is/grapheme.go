@@ -0,0 +1,22 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"github.com/maksliu/valid"
+	"github.com/rivo/uniseg"
+)
+
+// GraphemeLength returns a validation rule that checks if the number of grapheme clusters (user-perceived
+// characters) of a string or a byte slice is within the specified range. Unlike valid.RuneLength, an emoji made of several runes
+// (e.g. the flag "🇯🇵" or the family "👨‍👩‍👧") and a letter followed by combining marks (e.g. "é" written as "e" and
+// U+0301) each count as one character, so it is suitable for the limits shown to users such as "at most 20 characters".
+// If max is 0, it means there is no upper bound for the length. The clusters are segmented as specified by
+// Unicode Standard Annex #29 using github.com/rivo/uniseg.
+// The returned rule is a valid.LengthRule, so it is configured (e.g. with Trim) and reports errors like valid.Length.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func GraphemeLength(min, max int) valid.LengthRule {
+	return valid.Length(min, max).CountWith(uniseg.GraphemeClusterCount)
+}
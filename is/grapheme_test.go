@@ -0,0 +1,39 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+)
+
+func TestGraphemeLength(t *testing.T) {
+	tests := []struct {
+		tag      string
+		min, max int
+		value    interface{}
+		err      string
+	}{
+		{"t1", 2, 3, "", ""},
+		{"t2", 2, 3, "abc", ""},
+		{"t3", 2, 3, "abcd", "the length must be between 2 and 3"},
+		{"t4", 2, 3, "🇯🇵🇫🇷", ""},
+		{"t5", 1, 1, "🇯🇵", ""},
+		{"t6", 1, 1, "👨‍👩‍👧", ""},
+		{"t7", 1, 1, "é", ""},
+		{"t8", 2, 0, "👍🏽", "the length must be no less than 2"},
+		{"t9", 0, 2, "héllo", "the length must be no more than 2"},
+		{"t10", 2, 3, 123, "cannot get the length of int"},
+		{"t13", 1, 1, []byte("é"), ""},
+		{"t14", 1, 1, []byte("🇯🇵"), ""},
+		{"t15", 0, 2, []byte("héllo"), "the length must be no more than 2"},
+	}
+
+	for _, test := range tests {
+		err := GraphemeLength(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+	assertError(t, "", GraphemeLength(1, 2).Trim().Validate(" 🇯🇵 "), "t11")
+	assertError(t, "too long", GraphemeLength(0, 1).Error("too long").Validate("🇯🇵🇫🇷"), "t12")
+}
@@ -0,0 +1,58 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import "github.com/maksliu/valid"
+
+var (
+	// ErrGTIN is the error that returns in case of an invalid GTIN.
+	ErrGTIN = valid.NewError("validation_is_gtin", "must be a valid GTIN (8, 12, 13 or 14 digits with a correct check digit)")
+	// ErrEAN13 is the error that returns in case of an invalid EAN-13 code.
+	ErrEAN13 = valid.NewError("validation_is_ean13", "must be a valid EAN-13 code (13 digits with a correct check digit)")
+	// ErrUPC is the error that returns in case of an invalid UPC-A code.
+	ErrUPC = valid.NewError("validation_is_upc", "must be a valid UPC-A code (12 digits with a correct check digit)")
+)
+
+var (
+	// GTIN validates if a string is a valid Global Trade Item Number (GTIN-8, GTIN-12, GTIN-13 or GTIN-14)
+	GTIN = valid.NewStringRuleWithError(isGTIN, ErrGTIN)
+	// EAN13 validates if a string is a valid EAN-13 (GTIN-13) barcode number
+	EAN13 = valid.NewStringRuleWithError(isEAN13, ErrEAN13)
+	// UPC validates if a string is a valid UPC-A (GTIN-12) barcode number
+	UPC = valid.NewStringRuleWithError(isUPC, ErrUPC)
+)
+
+func isGTIN(value string) bool {
+	switch len(value) {
+	case 8, 12, 13, 14:
+		return isGTINChecksum(value)
+	}
+	return false
+}
+
+func isEAN13(value string) bool {
+	return len(value) == 13 && isGTINChecksum(value)
+}
+
+func isUPC(value string) bool {
+	return len(value) == 12 && isGTINChecksum(value)
+}
+
+// isGTINChecksum checks if a string consists of digits whose last one is the correct GS1 check digit.
+// Starting from the digit next to the check digit, the digits are weighted alternately by 3 and 1.
+func isGTINChecksum(value string) bool {
+	if !isDigit(value) {
+		return false
+	}
+	sum := 0
+	for i := len(value) - 2; i >= 0; i-- {
+		d := int(value[i] - '0')
+		if (len(value)-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return int(value[len(value)-1]-'0') == (10-sum%10)%10
+}
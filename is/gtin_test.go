@@ -0,0 +1,49 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+)
+
+func TestGTIN(t *testing.T) {
+	gtinErr := "must be a valid GTIN (8, 12, 13 or 14 digits with a correct check digit)"
+	eanErr := "must be a valid EAN-13 code (13 digits with a correct check digit)"
+	upcErr := "must be a valid UPC-A code (12 digits with a correct check digit)"
+	tests := []struct {
+		tag   string
+		rule  valid.Rule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", GTIN, "", ""},
+		{"t1.2", GTIN, "96385074", ""},
+		{"t1.3", GTIN, "036000291452", ""},
+		{"t1.4", GTIN, "4006381333931", ""},
+		{"t1.5", GTIN, "10614141000415", ""},
+		{"t1.6", GTIN, "4006381333932", gtinErr},
+		{"t1.7", GTIN, "400638133393", gtinErr},
+		{"t1.8", GTIN, "40063813339a1", gtinErr},
+		{"t1.9", GTIN, "123456789012345", gtinErr},
+		{"t1.10", GTIN, 4006381333931, "must be either a string or byte slice"},
+		{"t2.1", EAN13, "", ""},
+		{"t2.2", EAN13, "4006381333931", ""},
+		{"t2.3", EAN13, "9780306406157", ""},
+		{"t2.4", EAN13, "9780306406158", eanErr},
+		{"t2.5", EAN13, "036000291452", eanErr},
+		{"t3.1", UPC, "", ""},
+		{"t3.2", UPC, "036000291452", ""},
+		{"t3.3", UPC, "036000291453", upcErr},
+		{"t3.4", UPC, "4006381333931", upcErr},
+		{"t3.5", UPC, "03600029145", upcErr},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+// ErrHexLength is the error that returns in case of a hexadecimal string of a wrong length.
+var ErrHexLength = valid.NewError("validation_is_hex_length", "must be exactly {{.length}} hexadecimal characters")
+
+var (
+	// Hexadecimal validates if a string is a valid hexadecimal number.
+	// Call Length() to also check the number of hexadecimal characters.
+	Hexadecimal = HexadecimalRule{err: ErrHexadecimal, lengthErr: ErrHexLength}
+	// MD5 validates if a string is a hex-encoded MD5 hash (32 hexadecimal characters).
+	MD5 = Hexadecimal.Length(32)
+	// SHA1 validates if a string is a hex-encoded SHA-1 hash (40 hexadecimal characters).
+	SHA1 = Hexadecimal.Length(40)
+	// SHA256 validates if a string is a hex-encoded SHA-256 hash (64 hexadecimal characters).
+	SHA256 = Hexadecimal.Length(64)
+)
+
+// HexadecimalRule is a validation rule that checks if a string is a valid hexadecimal number.
+type HexadecimalRule struct {
+	length    int
+	err       valid.Error
+	lengthErr valid.Error
+}
+
+// Length configures the rule to only accept strings of exactly n hexadecimal characters,
+// e.g. Hexadecimal.Length(64) for a SHA-256 hash.
+func (r HexadecimalRule) Length(n int) HexadecimalRule {
+	r.length = n
+	r.lengthErr = r.lengthErr.SetParams(map[string]interface{}{"length": n})
+	return r
+}
+
+// Error sets the error message returned when the value is not a valid hexadecimal number.
+func (r HexadecimalRule) Error(message string) HexadecimalRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct returned when the value is not a valid hexadecimal number.
+func (r HexadecimalRule) ErrorObject(err valid.Error) HexadecimalRule {
+	r.err = err
+	return r
+}
+
+// LengthError sets the error message returned when the value is of a wrong length.
+func (r HexadecimalRule) LengthError(message string) HexadecimalRule {
+	r.lengthErr = r.lengthErr.SetMessage(message)
+	return r
+}
+
+// LengthErrorObject sets the error struct returned when the value is of a wrong length.
+func (r HexadecimalRule) LengthErrorObject(err valid.Error) HexadecimalRule {
+	r.lengthErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r HexadecimalRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if !govalidator.IsHexadecimal(str) {
+		return r.err
+	}
+	if r.length > 0 && len(str) != r.length {
+		return r.lengthErr
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHexadecimal(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  HexadecimalRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Hexadecimal, "", ""},
+		{"t1.2", Hexadecimal, "FEF", ""},
+		{"t1.3", Hexadecimal, "abc123", ""},
+		{"t1.4", Hexadecimal, "FTF", "must be a valid hexadecimal number"},
+		{"t1.5", Hexadecimal, 123, "must be either a string or byte slice"},
+		{"t2.1", Hexadecimal.Length(4), "abcd", ""},
+		{"t2.2", Hexadecimal.Length(4), "abc", "must be exactly 4 hexadecimal characters"},
+		{"t2.3", Hexadecimal.Length(4), "abcx", "must be a valid hexadecimal number"},
+		{"t3.1", MD5, "d41d8cd98f00b204e9800998ecf8427e", ""},
+		{"t3.2", MD5, "d41d8cd98f00b204e9800998ecf8427", "must be exactly 32 hexadecimal characters"},
+		{"t3.3", SHA1, "da39a3ee5e6b4b0d3255bfef95601890afd80709", ""},
+		{"t3.4", SHA1, "d41d8cd98f00b204e9800998ecf8427e", "must be exactly 40 hexadecimal characters"},
+		{"t3.5", SHA256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", ""},
+		{"t3.6", SHA256, []byte("E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"), ""},
+		{"t3.7", SHA256, strings.Repeat("g", 64), "must be a valid hexadecimal number"},
+		{"t3.8", SHA256, "da39a3ee5e6b4b0d3255bfef95601890afd80709", "must be exactly 64 hexadecimal characters"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := SHA256.Error("not hex").LengthError("not a SHA-256 hash")
+	assert.EqualError(t, r.Validate("xyz"), "not hex")
+	assert.EqualError(t, r.Validate("abc"), "not a SHA-256 hash")
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"net"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrHostPort is the error that returns in case of a value that is not in the host:port form.
+	ErrHostPort = valid.NewError("validation_is_host_port", "must be in the form host:port")
+	// ErrHostPortHost is the error that returns in case of a host:port value with an invalid host.
+	ErrHostPortHost = valid.NewError("validation_is_host_port_host", "must have a valid host (IP address or DNS name)")
+	// ErrHostPortPort is the error that returns in case of a host:port value with an invalid port.
+	ErrHostPortPort = valid.NewError("validation_is_host_port_port", "must have a valid port number (1-65535)")
+)
+
+// HostPort validates if a string is a host and a port joined by a colon, such as "example.com:80", "10.0.0.1:8080"
+// or "[::1]:443". The host must be an IP address or a DNS name, with IPv6 addresses enclosed in square brackets,
+// and the port must be a number between 1 and 65535.
+var HostPort = HostPortRule{err: ErrHostPort, hostErr: ErrHostPortHost, portErr: ErrHostPortPort}
+
+// HostPortRule is a validation rule that checks if a string is in the host:port form.
+type HostPortRule struct {
+	err     valid.Error
+	hostErr valid.Error
+	portErr valid.Error
+}
+
+// Error sets the error message returned when the value is not in the host:port form.
+func (r HostPortRule) Error(message string) HostPortRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct returned when the value is not in the host:port form.
+func (r HostPortRule) ErrorObject(err valid.Error) HostPortRule {
+	r.err = err
+	return r
+}
+
+// HostError sets the error message returned when the host is invalid.
+func (r HostPortRule) HostError(message string) HostPortRule {
+	r.hostErr = r.hostErr.SetMessage(message)
+	return r
+}
+
+// HostErrorObject sets the error struct returned when the host is invalid.
+func (r HostPortRule) HostErrorObject(err valid.Error) HostPortRule {
+	r.hostErr = err
+	return r
+}
+
+// PortError sets the error message returned when the port is invalid.
+func (r HostPortRule) PortError(message string) HostPortRule {
+	r.portErr = r.portErr.SetMessage(message)
+	return r
+}
+
+// PortErrorObject sets the error struct returned when the port is invalid.
+func (r HostPortRule) PortErrorObject(err valid.Error) HostPortRule {
+	r.portErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r HostPortRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	host, port, err := net.SplitHostPort(str)
+	if err != nil {
+		return r.err
+	}
+	if strings.HasPrefix(str, "[") {
+		// only IPv6 addresses may be enclosed in brackets
+		if net.ParseIP(host) == nil || !strings.Contains(host, ":") {
+			return r.hostErr
+		}
+	} else if !govalidator.IsHost(host) {
+		return r.hostErr
+	}
+	if !govalidator.IsPort(port) {
+		return r.portErr
+	}
+	return nil
+}
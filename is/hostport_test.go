@@ -0,0 +1,48 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostPort(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "example.com:80", ""},
+		{"t3", "localhost:8080", ""},
+		{"t4", "10.0.0.1:65535", ""},
+		{"t5", "[::1]:443", ""},
+		{"t6", []byte("[2001:db8::1]:8443"), ""},
+		{"t7", "example.com", "must be in the form host:port"},
+		{"t8", "::1:8080", "must be in the form host:port"},
+		{"t9", "[::1]", "must be in the form host:port"},
+		{"t10", ":8080", "must have a valid host (IP address or DNS name)"},
+		{"t11", "exa mple.com:80", "must have a valid host (IP address or DNS name)"},
+		{"t12", "[10.0.0.1]:80", "must have a valid host (IP address or DNS name)"},
+		{"t13", "[example.com]:80", "must have a valid host (IP address or DNS name)"},
+		{"t14", "example.com:0", "must have a valid port number (1-65535)"},
+		{"t15", "example.com:65536", "must have a valid port number (1-65535)"},
+		{"t16", "example.com:http", "must have a valid port number (1-65535)"},
+		{"t17", "example.com:", "must have a valid port number (1-65535)"},
+		{"t18", 8080, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := HostPort.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := HostPort.Error("bad address").HostError("bad host").PortError("bad port")
+	assert.EqualError(t, r.Validate("abc"), "bad address")
+	assert.EqualError(t, r.Validate("a b:80"), "bad host")
+	assert.EqualError(t, r.Validate("abc:0"), "bad port")
+}
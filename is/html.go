@@ -0,0 +1,104 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrNoHTML is the error that returns in case of a string containing HTML tags.
+	ErrNoHTML = valid.NewError("validation_is_no_html", "must not contain HTML tags")
+	// ErrSafeText is the error that returns in case of a string containing scripts or disallowed HTML tags.
+	ErrSafeText = valid.NewError("validation_is_safe_text", "must not contain scripts or disallowed HTML tags")
+)
+
+var (
+	// reTagStart matches the start of anything a browser may treat as markup: a tag, an end tag, a comment,
+	// a doctype or a processing instruction. A "<" followed by other characters (e.g. "a < b") is plain text.
+	reTagStart = regexp.MustCompile(`<[a-zA-Z/!?]`)
+	// rePlainTag matches a start, end or self-closing tag without attributes, e.g. "<b>", "</b>" or "<br/>".
+	rePlainTag = regexp.MustCompile(`^</?([a-zA-Z][a-zA-Z0-9]*)\s*/?>`)
+	// reScriptURL matches the URL schemes that execute scripts when followed.
+	reScriptURL = regexp.MustCompile(`(?:java|vb)script:`)
+)
+
+// NoHTML validates if a string contains no HTML tags, comments or other markup. A "<" that cannot start
+// markup (e.g. in "a < b") is allowed. This is defense-in-depth validation and does not replace output escaping.
+var NoHTML = valid.NewStringRuleWithError(func(value string) bool {
+	return !reTagStart.MatchString(value)
+}, ErrNoHTML)
+
+// SafeText validates if a string contains no scripts and no HTML tags other than the allowed ones.
+// A script is detected as a "javascript:" or "vbscript:" URL, also when it is obfuscated with HTML entities,
+// white space or letter case. Call AllowTags() to accept a limited set of tags such as "b" and "i". The allowed
+// tags must not have attributes, which also rules out event handlers such as onclick="...".
+// This is defense-in-depth validation and does not replace sanitization or output escaping.
+var SafeText = SafeTextRule{err: ErrSafeText}
+
+// SafeTextRule is a validation rule that checks if a string contains no scripts or disallowed HTML tags.
+type SafeTextRule struct {
+	tags map[string]bool
+	err  valid.Error
+}
+
+// AllowTags configures the rule to accept the given HTML tags (case-insensitive) as long as they have no attributes.
+func (r SafeTextRule) AllowTags(tags ...string) SafeTextRule {
+	allowed := make(map[string]bool, len(r.tags)+len(tags))
+	for tag := range r.tags {
+		allowed[tag] = true
+	}
+	for _, tag := range tags {
+		allowed[strings.ToLower(tag)] = true
+	}
+	r.tags = allowed
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r SafeTextRule) Error(message string) SafeTextRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SafeTextRule) ErrorObject(err valid.Error) SafeTextRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SafeTextRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	for _, loc := range reTagStart.FindAllStringIndex(str, -1) {
+		m := rePlainTag.FindStringSubmatch(str[loc[0]:])
+		if m == nil || !r.tags[strings.ToLower(m[1])] {
+			return r.err
+		}
+	}
+	if reScriptURL.MatchString(normalizeScriptText(str)) {
+		return r.err
+	}
+	return nil
+}
+
+// normalizeScriptText decodes the HTML entities in a string, lower-cases it and removes the white space and
+// control characters that browsers ignore within URL schemes.
+func normalizeScriptText(str string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, strings.ToLower(html.UnescapeString(str)))
+}
@@ -0,0 +1,81 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoHTML(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "I love Go & coffee", ""},
+		{"t3", "1 < 2 and 3 > 2", ""},
+		{"t4", "<3 Go", ""},
+		{"t5", "Hello <b>world</b>", "must not contain HTML tags"},
+		{"t6", "<script>alert(1)</script>", "must not contain HTML tags"},
+		{"t7", "text</p>", "must not contain HTML tags"},
+		{"t8", "<!-- comment -->", "must not contain HTML tags"},
+		{"t9", "<img src=x onerror=alert(1)", "must not contain HTML tags"},
+		{"t10", 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := NoHTML.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSafeText(t *testing.T) {
+	const msg = "must not contain scripts or disallowed HTML tags"
+	basic := SafeText.AllowTags("b", "I", "br")
+	tests := []struct {
+		tag   string
+		rule  SafeTextRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", SafeText, "", ""},
+		{"t1.2", SafeText, "I love Go & 1 < 2", ""},
+		{"t1.3", SafeText, "Hello <b>world</b>", msg},
+		{"t1.4", SafeText, "<a href=\"javascript:alert(1)\">x</a>", msg},
+		{"t1.5", SafeText, "click javascript:alert(1)", msg},
+		{"t1.6", SafeText, "JaVa\tScRiPt:alert(1)", msg},
+		{"t1.7", SafeText, "&#106;avascript&colon;alert(1)", msg},
+		{"t1.8", SafeText, "vbscript:msgbox", msg},
+		{"t1.9", SafeText, "I like Java scripts: they're fun", ""},
+		{"t1.10", SafeText, 123, "must be either a string or byte slice"},
+		{"t2.1", basic, "Hello <b>world</b> and <i>you</i><br/><BR >", ""},
+		{"t2.2", basic, "<B>bold</B>", ""},
+		{"t2.3", basic, "<script>alert(1)</script>", msg},
+		{"t2.4", basic, "<b onclick=\"alert(1)\">x</b>", msg},
+		{"t2.5", basic, "<b>x</b><u>y</u>", msg},
+		{"t2.6", basic, "<b", msg},
+		{"t2.7", basic, "<!-- <b> -->", msg},
+		{"t2.8", basic, "one = two, onion=three", ""},
+		{"t3.1", SafeText.AllowTags("b").AllowTags("i"), "<b><i>x</i></b>", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// AllowTags does not modify the rule it is called on
+	assertError(t, msg, SafeText.Validate("<b>x</b>"), "t4")
+
+	err := SafeText.Validate("<b>")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_safe_text", err.(valid.Error).Code())
+	}
+	assert.EqualError(t, SafeText.Error("no markup").Validate("<b>"), "no markup")
+}
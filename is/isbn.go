@@ -0,0 +1,214 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrISBNLength is the error that returns in case of an ISBN that does not have 10 or 13 digits.
+	ErrISBNLength = valid.NewError("validation_is_isbn_length", "must be an ISBN of 10 or 13 digits")
+	// ErrISBNCheckDigit is the error that returns in case of an ISBN whose check digit is not correct.
+	ErrISBNCheckDigit = valid.NewError("validation_is_isbn_check_digit", "must be an ISBN with a correct check digit")
+	// ErrISBNGroup is the error that returns in case of an ISBN outside the accepted registration groups.
+	ErrISBNGroup = valid.NewError("validation_is_isbn_group", "must be an ISBN of the registration groups {{.groups}}")
+)
+
+// ISBN validates if a string is an ISBN-10 or an ISBN-13, which may contain hyphens and spaces between the digits
+// (e.g. "978-4-87311-368-5"). A string with a wrong number of digits and one with a wrong check digit are reported
+// with ErrISBNLength and ErrISBNCheckDigit respectively, and any other malformed string with ErrISBN.
+// Call Normalize() to also accept the ISBNs of messy imported data and Groups() to only accept some registration groups.
+// Use Parse to find out which form an ISBN has.
+var ISBN = ISBNRule{err: ErrISBN, lengthErr: ErrISBNLength, checkDigitErr: ErrISBNCheckDigit, groupErr: ErrISBNGroup}
+
+// ISBNRule is a validation rule that checks if a string is an ISBN.
+type ISBNRule struct {
+	normalize     bool
+	groups        []string
+	err           valid.Error
+	lengthErr     valid.Error
+	checkDigitErr valid.Error
+	groupErr      valid.Error
+}
+
+// ISBNInfo describes a valid ISBN returned by ISBNRule.Parse.
+type ISBNInfo struct {
+	// Form is the form of the ISBN, which is either 10 or 13.
+	Form int
+	// Digits is the ISBN without the separators, e.g. "9784873113685". The check digit X of an ISBN-10 is upper case.
+	Digits string
+}
+
+// Normalize configures the rule to clean up an ISBN before checking it: all kinds of dashes (e.g. en dashes)
+// and white space (e.g. non-breaking spaces) are removed, a leading "ISBN", "ISBN-10" or "ISBN-13" label with
+// an optional colon is ignored, and a lower case check digit x is accepted, e.g. "ISBN-10: 0 8044 2957 x".
+func (r ISBNRule) Normalize() ISBNRule {
+	r.normalize = true
+	return r
+}
+
+// Groups configures the rule to only accept the ISBNs of the given registration groups. Each group is given with
+// its ISBN-13 prefix, in which hyphens are ignored, e.g. "978-4" for Japan or "979-10" for France, and a prefix
+// alone (e.g. "979") accepts all its groups. An ISBN-10 belongs to the groups of the 978 prefix.
+// The error of the rule has the "groups" parameter set to the list of the groups.
+func (r ISBNRule) Groups(groups ...string) ISBNRule {
+	r.groups = make([]string, len(groups))
+	for i, g := range groups {
+		r.groups[i] = strings.ReplaceAll(g, "-", "")
+	}
+	r.groupErr = r.groupErr.SetParams(map[string]interface{}{"groups": strings.Join(groups, ", ")})
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r ISBNRule) Error(message string) ISBNRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ISBNRule) ErrorObject(err valid.Error) ISBNRule {
+	r.err = err
+	return r
+}
+
+// LengthError sets the error message returned when an ISBN does not have 10 or 13 digits.
+func (r ISBNRule) LengthError(message string) ISBNRule {
+	r.lengthErr = r.lengthErr.SetMessage(message)
+	return r
+}
+
+// LengthErrorObject sets the error struct returned when an ISBN does not have 10 or 13 digits.
+func (r ISBNRule) LengthErrorObject(err valid.Error) ISBNRule {
+	r.lengthErr = err
+	return r
+}
+
+// CheckDigitError sets the error message returned when the check digit of an ISBN is not correct.
+func (r ISBNRule) CheckDigitError(message string) ISBNRule {
+	r.checkDigitErr = r.checkDigitErr.SetMessage(message)
+	return r
+}
+
+// CheckDigitErrorObject sets the error struct returned when the check digit of an ISBN is not correct.
+func (r ISBNRule) CheckDigitErrorObject(err valid.Error) ISBNRule {
+	r.checkDigitErr = err
+	return r
+}
+
+// GroupError sets the error message returned when an ISBN is not of the accepted registration groups.
+func (r ISBNRule) GroupError(message string) ISBNRule {
+	r.groupErr = r.groupErr.SetMessage(message)
+	return r
+}
+
+// GroupErrorObject sets the error struct returned when an ISBN is not of the accepted registration groups.
+func (r ISBNRule) GroupErrorObject(err valid.Error) ISBNRule {
+	r.groupErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ISBNRule) Validate(value interface{}) error {
+	_, err := r.Parse(value)
+	return err
+}
+
+// Parse checks the given value like Validate does and returns the form and the digits of the ISBN if it is valid.
+// An empty ISBNInfo is returned for an empty value, which is considered valid.
+func (r ISBNRule) Parse(value interface{}) (ISBNInfo, error) {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return ISBNInfo{}, err
+	}
+
+	digits, ok := r.digits(str)
+	if !ok {
+		return ISBNInfo{}, r.err
+	}
+	if len(digits) != 10 && len(digits) != 13 {
+		return ISBNInfo{}, r.lengthErr
+	}
+	if len(digits) == 13 && digits[12] == 'X' {
+		return ISBNInfo{}, r.err
+	}
+	if len(digits) == 10 && !isISBN10Checksum(digits) || len(digits) == 13 && !isGTINChecksum(digits) {
+		return ISBNInfo{}, r.checkDigitErr
+	}
+	if len(r.groups) > 0 && !r.inGroups(digits) {
+		return ISBNInfo{}, r.groupErr
+	}
+	return ISBNInfo{Form: len(digits), Digits: digits}, nil
+}
+
+// digits returns the digits of an ISBN without the separators. The boolean result is false if the string
+// contains any other character, or a check digit X anywhere but at the end.
+func (r ISBNRule) digits(str string) (string, bool) {
+	if r.normalize {
+		str = trimISBNLabel(strings.TrimSpace(str))
+	}
+	var b strings.Builder
+	for _, c := range str {
+		switch {
+		case c >= '0' && c <= '9' || c == 'X' || c == 'x' && r.normalize:
+			b.WriteRune(unicode.ToUpper(c))
+		case c == '-' || c == ' ' || c == '\t' || c == '\n' || c == '\f' || c == '\r':
+		case r.normalize && (unicode.Is(unicode.Pd, c) || c == '\u2212' || unicode.IsSpace(c)):
+		default:
+			return "", false
+		}
+	}
+	digits := b.String()
+	if i := strings.IndexByte(digits, 'X'); i >= 0 && i != len(digits)-1 {
+		return "", false
+	}
+	return digits, digits != ""
+}
+
+// inGroups reports whether an ISBN belongs to one of the registration groups of the rule.
+func (r ISBNRule) inGroups(digits string) bool {
+	if len(digits) == 10 {
+		digits = "978" + digits
+	}
+	for _, g := range r.groups {
+		if strings.HasPrefix(digits, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimISBNLabel removes a leading "ISBN", "ISBN-10" or "ISBN-13" label (in any case) followed by an optional colon.
+func trimISBNLabel(str string) string {
+	if len(str) < 4 || !strings.EqualFold(str[:4], "ISBN") {
+		return str
+	}
+	str = str[4:]
+	for _, label := range []string{"-10", "-13"} {
+		if strings.HasPrefix(str, label) {
+			str = str[len(label):]
+			break
+		}
+	}
+	return strings.TrimPrefix(strings.TrimSpace(str), ":")
+}
+
+// isISBN10Checksum checks if the digits of an ISBN-10 have the correct check digit, which is X for 10.
+// The digits are weighted from 10 down to 1, and the weighted sum must be a multiple of 11.
+func isISBN10Checksum(digits string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		d := int(digits[i] - '0')
+		if digits[i] == 'X' {
+			d = 10
+		}
+		sum += (10 - i) * d
+	}
+	return sum%11 == 0
+}
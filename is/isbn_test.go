@@ -0,0 +1,113 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISBN(t *testing.T) {
+	formatErr := "must be a valid ISBN"
+	lengthErr := "must be an ISBN of 10 or 13 digits"
+	checkDigitErr := "must be an ISBN with a correct check digit"
+	tests := []struct {
+		tag   string
+		rule  valid.Rule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", ISBN, "", ""},
+		{"t1.2", ISBN, "1-61729-085-8", ""},
+		{"t1.3", ISBN, "1617290858", ""},
+		{"t1.4", ISBN, "978-4-87311-368-5", ""},
+		{"t1.5", ISBN, "978 4 87311 368 5", ""},
+		{"t1.6", ISBN, "0-8044-2957-X", ""},
+		{"t1.7", ISBN, []byte("9784873113685"), ""},
+		{"t1.8", ISBN, 9784873113685, "must be either a string or byte slice"},
+		// malformed
+		{"t2.1", ISBN, "1-61729-O85-8", formatErr},
+		{"t2.2", ISBN, "0-8044-2957-x", formatErr},
+		{"t2.3", ISBN, "08044X2957", formatErr},
+		{"t2.4", ISBN, "978487311368X", formatErr},
+		{"t2.5", ISBN, "---", formatErr},
+		{"t2.6", ISBN, "ISBN 978-4-87311-368-5", formatErr},
+		{"t2.7", ISBN, "978–4–87311–368–5", formatErr},
+		// wrong length and check digit
+		{"t3.1", ISBN, "1-61729-085-81", lengthErr},
+		{"t3.2", ISBN, "16172908", lengthErr},
+		{"t3.3", ISBN, "97848731136851", lengthErr},
+		{"t3.4", ISBN, "1-61729-085-9", checkDigitErr},
+		{"t3.5", ISBN, "978-4-87311-368-6", checkDigitErr},
+		{"t3.6", ISBN, "0-8044-2957-1", checkDigitErr},
+		// normalized
+		{"t4.1", ISBN.Normalize(), "0-8044-2957-x", ""},
+		{"t4.2", ISBN.Normalize(), "ISBN 978-4-87311-368-5", ""},
+		{"t4.3", ISBN.Normalize(), "ISBN-13: 978-4-87311-368-5", ""},
+		{"t4.4", ISBN.Normalize(), "isbn-10:0-8044-2957-X", ""},
+		{"t4.5", ISBN.Normalize(), " 978–4–87311—368− 5 ", ""},
+		{"t4.6", ISBN.Normalize(), "ISBN: 1-61729-085-9", checkDigitErr},
+		{"t4.7", ISBN.Normalize(), "ISBN", formatErr},
+		{"t4.8", ISBN.Normalize(), "978.4.87311.368.5", formatErr},
+		{"t4.9", ISBN.Normalize(), "ISBN-13: 978-4-87311-368", lengthErr},
+		// registration groups
+		{"t5.1", ISBN.Groups("978-4"), "978-4-87311-368-5", ""},
+		{"t5.2", ISBN.Groups("978-4"), "4-87311-368-7", ""},
+		{"t5.3", ISBN.Groups("978-0", "978-1"), "978-4-87311-368-5", "must be an ISBN of the registration groups 978-0, 978-1"},
+		{"t5.4", ISBN.Groups("978-0", "978-1"), "1-61729-085-8", ""},
+		{"t5.5", ISBN.Groups("979-10"), "979-10-90636-07-1", ""},
+		{"t5.6", ISBN.Groups("979"), "1-61729-085-8", "must be an ISBN of the registration groups 979"},
+		{"t5.7", ISBN.Groups("978-4"), "978-4-87311-368-6", checkDigitErr},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestISBNRule_Parse(t *testing.T) {
+	info, err := ISBN.Parse("1-61729-085-8")
+	assert.Nil(t, err)
+	assert.Equal(t, ISBNInfo{Form: 10, Digits: "1617290858"}, info)
+
+	info, err = ISBN.Normalize().Parse("ISBN-10: 0 8044 2957 x")
+	assert.Nil(t, err)
+	assert.Equal(t, ISBNInfo{Form: 10, Digits: "080442957X"}, info)
+
+	info, err = ISBN.Parse("978-4-87311-368-5")
+	assert.Nil(t, err)
+	assert.Equal(t, ISBNInfo{Form: 13, Digits: "9784873113685"}, info)
+
+	info, err = ISBN.Parse("")
+	assert.Nil(t, err)
+	assert.Equal(t, ISBNInfo{}, info)
+
+	info, err = ISBN.Parse("978-4-87311-368-6")
+	assertError(t, "must be an ISBN with a correct check digit", err, "t1")
+	assert.Equal(t, ISBNInfo{}, info)
+}
+
+func TestISBNRule_Error(t *testing.T) {
+	r := ISBN.Error("bad ISBN").LengthError("bad length").CheckDigitError("bad check digit").GroupError("bad group").Groups("978-4")
+	assertError(t, "bad ISBN", r.Validate("abc"), "t1")
+	assertError(t, "bad length", r.Validate("123"), "t2")
+	assertError(t, "bad check digit", r.Validate("978-4-87311-368-6"), "t3")
+	assertError(t, "bad group", r.Validate("1-61729-085-8"), "t4")
+
+	e := valid.NewError("code", "abc")
+	r = ISBN.Groups("978-4").ErrorObject(e).LengthErrorObject(e).CheckDigitErrorObject(e).GroupErrorObject(e)
+	for _, value := range []string{"abc", "123", "978-4-87311-368-6", "1-61729-085-8"} {
+		assert.Equal(t, e, r.Validate(value))
+	}
+
+	err := ISBN.Groups("978-4").Validate("1-61729-085-8")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_isbn_group", err.(valid.Error).Code())
+		assert.Equal(t, "978-4", err.(valid.Error).Params()["groups"])
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+
+	"github.com/maksliu/valid"
+	"golang.org/x/text/language"
+)
+
+var (
+	// ErrLanguageTag is the error that returns in case of an invalid BCP 47 language tag.
+	ErrLanguageTag = valid.NewError("validation_is_language_tag", "must be a valid BCP 47 language tag")
+	// ErrLanguageTagUnsupported is the error that returns in case of a language tag not in the supported set.
+	ErrLanguageTagUnsupported = valid.NewError("validation_is_language_tag_unsupported", "must be a supported language")
+)
+
+// LanguageTag validates if a string is a well-formed BCP 47 language tag (e.g. "en-US", "zh-Hant-TW")
+// made of registered subtags. Tags are case-insensitive, but the subtags must be separated by hyphens.
+// Call InSet() to restrict the tags to a set of supported languages.
+var LanguageTag = LanguageTagRule{err: ErrLanguageTag, unsupportedErr: ErrLanguageTagUnsupported}
+
+// LanguageTagRule is a validation rule that checks if a string is a valid BCP 47 language tag.
+type LanguageTagRule struct {
+	tags           []language.Tag
+	err            valid.Error
+	unsupportedErr valid.Error
+}
+
+// InSet configures the rule to only accept the given language tags or their more specific forms.
+// For example, InSet("en", "zh-Hant") accepts "en", "en-US" and "zh-Hant-TW", but not "de".
+// InSet panics if any of the given tags is invalid.
+func (r LanguageTagRule) InSet(tags ...string) LanguageTagRule {
+	r.tags = make([]language.Tag, len(tags))
+	for i, tag := range tags {
+		r.tags[i] = language.MustParse(tag)
+	}
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r LanguageTagRule) Error(message string) LanguageTagRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r LanguageTagRule) ErrorObject(err valid.Error) LanguageTagRule {
+	r.err = err
+	return r
+}
+
+// UnsupportedError sets the error message returned when a language tag is not in the supported set.
+func (r LanguageTagRule) UnsupportedError(message string) LanguageTagRule {
+	r.unsupportedErr = r.unsupportedErr.SetMessage(message)
+	return r
+}
+
+// UnsupportedErrorObject sets the error struct returned when a language tag is not in the supported set.
+func (r LanguageTagRule) UnsupportedErrorObject(err valid.Error) LanguageTagRule {
+	r.unsupportedErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r LanguageTagRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if strings.Contains(str, "_") {
+		return r.err
+	}
+	tag, err := language.Parse(str)
+	if err != nil {
+		return r.err
+	}
+	if r.tags == nil {
+		return nil
+	}
+	for t := tag; ; t = t.Parent() {
+		for _, supported := range r.tags {
+			if t == supported {
+				return nil
+			}
+		}
+		if t == language.Und {
+			return r.unsupportedErr
+		}
+	}
+}
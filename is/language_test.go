@@ -0,0 +1,52 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageTag(t *testing.T) {
+	supported := LanguageTag.InSet("en", "de", "zh-Hant")
+	tests := []struct {
+		tag   string
+		rule  LanguageTagRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", LanguageTag, "", ""},
+		{"t1.2", LanguageTag, "en", ""},
+		{"t1.3", LanguageTag, "en-US", ""},
+		{"t1.4", LanguageTag, "zh-Hant-TW", ""},
+		{"t1.5", LanguageTag, "EN-us", ""},
+		{"t1.6", LanguageTag, "de-CH-1996", ""},
+		{"t1.7", LanguageTag, []byte("fr"), ""},
+		{"t1.8", LanguageTag, "en_US", "must be a valid BCP 47 language tag"},
+		{"t1.9", LanguageTag, "en-", "must be a valid BCP 47 language tag"},
+		{"t1.10", LanguageTag, "123", "must be a valid BCP 47 language tag"},
+		{"t1.11", LanguageTag, "xx", "must be a valid BCP 47 language tag"},
+		{"t1.12", LanguageTag, 123, "must be either a string or byte slice"},
+		{"t2.1", supported, "", ""},
+		{"t2.2", supported, "en", ""},
+		{"t2.3", supported, "en-GB", ""},
+		{"t2.4", supported, "de", ""},
+		{"t2.5", supported, "zh-Hant-TW", ""},
+		{"t2.6", supported, "zh-Hans", "must be a supported language"},
+		{"t2.7", supported, "fr", "must be a supported language"},
+		{"t2.8", supported, "en_US", "must be a valid BCP 47 language tag"},
+		{"t2.9", LanguageTag.InSet("en-US"), "en", "must be a supported language"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, LanguageTag.Error("bad tag").Validate("en_US"), "bad tag")
+	assert.EqualError(t, supported.UnsupportedError("not supported").Validate("fr"), "not supported")
+	assert.Panics(t, func() { LanguageTag.InSet("en_") })
+}
@@ -0,0 +1,23 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"embed"
+
+	"github.com/maksliu/valid"
+)
+
+// locales holds the translations of the errors of the rules, which are registered in the message catalog
+// of the valid package, so that they are reported in the locale set by valid.SetLocale or valid.WithLocale.
+//
+//go:embed locales/*.json
+var locales embed.FS
+
+func init() {
+	if err := valid.RegisterMessagesFS(locales, "locales"); err != nil {
+		panic(err)
+	}
+}
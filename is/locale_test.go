@@ -0,0 +1,36 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+)
+
+func TestLocale(t *testing.T) {
+	defer valid.SetLocale("")
+
+	tests := []struct {
+		tag      string
+		locale   string
+		value    interface{}
+		rule     valid.Rule
+		expected string
+	}{
+		{"t1", "", "abc", Email, "must be a valid email address"},
+		{"t2", "fr", "abc", Email, "doit être une adresse e-mail valide"},
+		{"t3", "es", "abc", UUID, "debe ser un UUID válido"},
+		{"t4", "de-AT", "abc", IPv4, "muss eine gültige IPv4-Adresse sein"},
+		{"t5", "fr", "ab@c", PostalCode("XX"), "doit être un code postal valide"},
+		{"t6", "fr", "abc", PostalCode("US"), "doit être un code postal valide (p. ex. " + PostalCode("US").err.Params()["example"].(string) + ")"},
+		{"t7", "fr", "abc", Email.Error("is not an email"), "is not an email"},
+	}
+
+	for _, test := range tests {
+		valid.SetLocale(test.locale)
+		assertError(t, test.expected, valid.Validate(test.value, test.rule), test.tag)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrMimeType is the error that returns in case of an invalid MIME type.
+	ErrMimeType = valid.NewError("validation_is_mime_type", "must be a valid MIME type")
+	// ErrMimeTypeUnsupported is the error that returns in case of a MIME type not in the allowed list.
+	ErrMimeTypeUnsupported = valid.NewError("validation_is_mime_type_unsupported", "must be a supported MIME type")
+)
+
+// MimeType validates if a string is a valid MIME type in the form of "type/subtype", optionally followed by
+// parameters (e.g. "text/html; charset=UTF-8"), as defined in RFC 2045.
+// Call In() to only accept a list of MIME types.
+var MimeType = MimeTypeRule{err: ErrMimeType, unsupportedErr: ErrMimeTypeUnsupported}
+
+// MimeTypeRule is a validation rule that checks if a string is a valid MIME type.
+type MimeTypeRule struct {
+	types          []string
+	err            valid.Error
+	unsupportedErr valid.Error
+}
+
+// In configures the rule to only accept the given MIME types. The parameters of the value are ignored when
+// checking against the list, and the comparison is case-insensitive. A type may use "*" as its subtype to accept
+// all subtypes, e.g. In("image/*", "application/pdf") accepts "image/png" but not "text/plain".
+func (r MimeTypeRule) In(types ...string) MimeTypeRule {
+	r.types = make([]string, len(types))
+	for i, t := range types {
+		r.types[i] = strings.ToLower(t)
+	}
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r MimeTypeRule) Error(message string) MimeTypeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MimeTypeRule) ErrorObject(err valid.Error) MimeTypeRule {
+	r.err = err
+	return r
+}
+
+// UnsupportedError sets the error message returned when a MIME type is not in the allowed list.
+func (r MimeTypeRule) UnsupportedError(message string) MimeTypeRule {
+	r.unsupportedErr = r.unsupportedErr.SetMessage(message)
+	return r
+}
+
+// UnsupportedErrorObject sets the error struct returned when a MIME type is not in the allowed list.
+func (r MimeTypeRule) UnsupportedErrorObject(err valid.Error) MimeTypeRule {
+	r.unsupportedErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r MimeTypeRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(str)
+	if err != nil || !strings.Contains(mediaType, "/") {
+		return r.err
+	}
+	if r.types == nil || matchMimeType(r.types, mediaType) {
+		return nil
+	}
+	return r.unsupportedErr
+}
+
+// matchMimeType reports whether the media type matches one of the given lower-case MIME types,
+// which may use "*" as their subtype.
+func matchMimeType(types []string, mediaType string) bool {
+	for _, t := range types {
+		if t == mediaType || strings.HasSuffix(t, "/*") && strings.HasPrefix(mediaType, t[:len(t)-1]) {
+			return true
+		}
+	}
+	return false
+}
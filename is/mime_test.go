@@ -0,0 +1,51 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMimeType(t *testing.T) {
+	images := MimeType.In("image/png", "image/jpeg")
+	tests := []struct {
+		tag   string
+		rule  MimeTypeRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", MimeType, "", ""},
+		{"t1.2", MimeType, "text/plain", ""},
+		{"t1.3", MimeType, "Text/HTML; charset=UTF-8", ""},
+		{"t1.4", MimeType, `multipart/form-data; boundary="a;b"`, ""},
+		{"t1.5", MimeType, "application/vnd.api+json", ""},
+		{"t1.6", MimeType, []byte("image/png"), ""},
+		{"t1.7", MimeType, "text", "must be a valid MIME type"},
+		{"t1.8", MimeType, "text/", "must be a valid MIME type"},
+		{"t1.9", MimeType, "/plain", "must be a valid MIME type"},
+		{"t1.10", MimeType, "a/b/c", "must be a valid MIME type"},
+		{"t1.11", MimeType, "text /plain", "must be a valid MIME type"},
+		{"t1.12", MimeType, "text/plain; charset", "must be a valid MIME type"},
+		{"t1.13", MimeType, 123, "must be either a string or byte slice"},
+		{"t2.1", images, "", ""},
+		{"t2.2", images, "image/png", ""},
+		{"t2.3", images, "IMAGE/JPEG; q=0.9", ""},
+		{"t2.4", images, "image/gif", "must be a supported MIME type"},
+		{"t2.5", images, "image", "must be a valid MIME type"},
+		{"t3.1", MimeType.In("image/*"), "image/webp", ""},
+		{"t3.2", MimeType.In("image/*"), "text/plain", "must be a supported MIME type"},
+		{"t3.3", MimeType.In("Application/PDF"), "application/pdf", ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, MimeType.Error("bad type").Validate("text"), "bad type")
+	assert.EqualError(t, images.UnsupportedError("no gifs").Validate("image/gif"), "no gifs")
+}
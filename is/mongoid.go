@@ -0,0 +1,130 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrMongoIDZero is the error that returns in case of the zero ObjectId (all zeros) when it is rejected.
+	ErrMongoIDZero = valid.NewError("validation_is_mongo_id_zero", "must not be the zero ObjectId")
+	// ErrMongoIDTimestamp is the error that returns in case of an ObjectId whose timestamp is out of the allowed range.
+	ErrMongoIDTimestamp = valid.NewError("validation_is_mongo_id_timestamp", "must have a plausible creation time")
+)
+
+const zeroMongoID = "000000000000000000000000"
+
+// MongoID validates if a string is a valid hex-encoded MongoDB ObjectId (e.g. 507f1f77bcf86cd799439011).
+// Call NotZero() to reject the zero ObjectId, and CreatedAfter() and NotInFuture() to check the creation time
+// embedded in the ObjectId, which catches placeholder and garbage IDs.
+var MongoID = MongoIDRule{err: ErrMongoID, zeroErr: ErrMongoIDZero, timestampErr: ErrMongoIDTimestamp}
+
+// MongoIDRule is a validation rule that checks if a string is a valid hex-encoded MongoDB ObjectId.
+type MongoIDRule struct {
+	notZero      bool
+	after        time.Time
+	checkFuture  bool
+	leeway       time.Duration
+	err          valid.Error
+	zeroErr      valid.Error
+	timestampErr valid.Error
+}
+
+// MongoIDTime returns the creation time embedded in the first 4 bytes of a hex-encoded MongoDB ObjectId,
+// with a precision of one second. The boolean result is false if the string is not a valid ObjectId.
+func MongoIDTime(id string) (time.Time, bool) {
+	if !govalidator.IsMongoID(id) {
+		return time.Time{}, false
+	}
+	b, err := hex.DecodeString(id[:8])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(binary.BigEndian.Uint32(b)), 0).UTC(), true
+}
+
+// NotZero configures the rule to reject the zero ObjectId (000000000000000000000000), which is syntactically valid
+// but usually a placeholder.
+func (r MongoIDRule) NotZero() MongoIDRule {
+	r.notZero = true
+	return r
+}
+
+// CreatedAfter configures the rule to reject ObjectIds created before the given time, e.g. the launch date
+// of the application.
+func (r MongoIDRule) CreatedAfter(t time.Time) MongoIDRule {
+	r.after = t
+	return r
+}
+
+// NotInFuture configures the rule to reject ObjectIds created later than the current time plus the given leeway,
+// which allows for clock differences between the servers. The current time is taken whenever a value is validated.
+func (r MongoIDRule) NotInFuture(leeway time.Duration) MongoIDRule {
+	r.checkFuture = true
+	r.leeway = leeway
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r MongoIDRule) Error(message string) MongoIDRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MongoIDRule) ErrorObject(err valid.Error) MongoIDRule {
+	r.err = err
+	return r
+}
+
+// ZeroError sets the error message returned when the zero ObjectId is rejected.
+func (r MongoIDRule) ZeroError(message string) MongoIDRule {
+	r.zeroErr = r.zeroErr.SetMessage(message)
+	return r
+}
+
+// ZeroErrorObject sets the error struct returned when the zero ObjectId is rejected.
+func (r MongoIDRule) ZeroErrorObject(err valid.Error) MongoIDRule {
+	r.zeroErr = err
+	return r
+}
+
+// TimestampError sets the error message returned when the creation time of an ObjectId is out of the allowed range.
+func (r MongoIDRule) TimestampError(message string) MongoIDRule {
+	r.timestampErr = r.timestampErr.SetMessage(message)
+	return r
+}
+
+// TimestampErrorObject sets the error struct returned when the creation time of an ObjectId is out of the allowed range.
+func (r MongoIDRule) TimestampErrorObject(err valid.Error) MongoIDRule {
+	r.timestampErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r MongoIDRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	t, ok := MongoIDTime(str)
+	if !ok {
+		return r.err
+	}
+	if r.notZero && str == zeroMongoID {
+		return r.zeroErr
+	}
+	if !r.after.IsZero() && t.Before(r.after) || r.checkFuture && t.After(time.Now().Add(r.leeway)) {
+		return r.timestampErr
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMongoID(t *testing.T) {
+	var empty *string
+	id := "507f1f77bcf86cd799439011" // created at 2012-10-17T21:13:27Z
+	launch := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := fmt.Sprintf("%08x0000000000000000", time.Now().Add(time.Hour).Unix())
+	tests := []struct {
+		tag   string
+		rule  MongoIDRule
+		value interface{}
+		err   string
+	}{
+		{"t1", MongoID, "", ""},
+		{"t2", MongoID, empty, ""},
+		{"t3", MongoID, id, ""},
+		{"t4", MongoID, &id, ""},
+		{"t5", MongoID, []byte("507F1F77BCF86CD799439011"), ""},
+		{"t6", MongoID, "507f1f77bcf86cd79943901", "must be a valid hex-encoded MongoDB ObjectId"},
+		{"t7", MongoID, "507f1f77bcf86cd79943901g", "must be a valid hex-encoded MongoDB ObjectId"},
+		{"t8", MongoID, 123, "must be either a string or byte slice"},
+		{"t9", MongoID, zeroMongoID, ""},
+		{"t10", MongoID.NotZero(), zeroMongoID, "must not be the zero ObjectId"},
+		{"t11", MongoID.NotZero(), id, ""},
+		{"t12", MongoID.NotZero().CreatedAfter(launch), zeroMongoID, "must not be the zero ObjectId"},
+		{"t13", MongoID.CreatedAfter(launch), id, "must have a plausible creation time"},
+		{"t14", MongoID.CreatedAfter(time.Date(2012, 10, 17, 21, 13, 27, 0, time.UTC)), id, ""},
+		{"t15", MongoID, future, ""},
+		{"t16", MongoID.NotInFuture(time.Minute), future, "must have a plausible creation time"},
+		{"t17", MongoID.NotInFuture(2 * time.Hour), future, ""},
+		{"t18", MongoID.NotInFuture(0), id, ""},
+		{"t19", MongoID.NotInFuture(0), "ffffffff0000000000000000", "must have a plausible creation time"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMongoIDTime(t *testing.T) {
+	created, ok := MongoIDTime("507f1f77bcf86cd799439011")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2012, 10, 17, 21, 13, 27, 0, time.UTC), created)
+
+	_, ok = MongoIDTime("507f1f77")
+	assert.False(t, ok)
+}
+
+func TestMongoIDRule_Error(t *testing.T) {
+	r := MongoID.NotZero().NotInFuture(0).Error("a").ZeroError("b").TimestampError("c")
+	assert.Equal(t, "a", r.Validate("xyz").Error())
+	assert.Equal(t, "b", r.Validate(zeroMongoID).Error())
+	assert.Equal(t, "c", r.Validate("ffffffff0000000000000000").Error())
+
+	err := valid.NewError("code", "abc")
+	r = MongoID.NotZero().CreatedAfter(time.Now()).ErrorObject(err).ZeroErrorObject(err).TimestampErrorObject(err)
+	assert.Equal(t, err, r.Validate("xyz"))
+	assert.Equal(t, err, r.Validate(zeroMongoID))
+	assert.Equal(t, err, r.Validate("507f1f77bcf86cd799439011"))
+}
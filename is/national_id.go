@@ -0,0 +1,135 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrSIN is the error that returns in case of an invalid Canadian social insurance number.
+	ErrSIN = valid.NewError("validation_is_sin", "must be a valid social insurance number")
+	// ErrNINO is the error that returns in case of an invalid UK National Insurance number.
+	ErrNINO = valid.NewError("validation_is_nino", "must be a valid National Insurance number")
+)
+
+type nationalIDFormat struct {
+	validate func(string) bool
+	masked   *regexp.Regexp
+	err      valid.Error
+}
+
+var (
+	reSIN  = regexp.MustCompile(`^\d{3}([- ]?)\d{3}([- ]?)\d{3}$`)
+	reNINO = regexp.MustCompile(`(?i)^[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]$`)
+)
+
+// nationalIDFormats lists the supported national identification numbers indexed by the ISO 3166-1 alpha-2 country codes.
+// A masked form hides all but the trailing part of the number behind "X" or "*" characters.
+var nationalIDFormats = map[string]nationalIDFormat{
+	"CA": {isSIN, regexp.MustCompile(`(?i)^[X*]{3}[- ]?[X*]{3}[- ]?\d{3}$`), ErrSIN},
+	"GB": {isNINO, regexp.MustCompile(`(?i)^[X*]{2} ?[X*]{2} ?[X*]{2} ?\d{2} ?[A-D]$`), ErrNINO},
+	"US": {govalidator.IsSSN, regexp.MustCompile(`(?i)^[X*]{3}[- ]?[X*]{2}[- ]?\d{4}$`), ErrSSN},
+}
+
+// SSN validates if a string is a US social security number (SSN), e.g. "123-45-6789".
+// It is equivalent to NationalID("US"). Call AllowMasked() to also accept masked numbers such as "XXX-XX-6789".
+var SSN = NationalID("US")
+
+// NationalID returns a validation rule that checks if a string is a valid national identification number of the
+// given country, specified as an ISO 3166-1 alpha-2 code (case-insensitive). The following countries are supported:
+//   - CA: social insurance number (SIN) of 9 digits, optionally grouped by 3 with hyphens or spaces
+//     (e.g. "130-692-544"), whose check digit is verified with the Luhn algorithm
+//   - GB: National Insurance number (NINO), e.g. "AB 12 34 56 C"
+//   - US: social security number (SSN), e.g. "123-45-6789"
+//
+// NationalID panics if the country is not supported.
+func NationalID(country string) NationalIDRule {
+	f, ok := nationalIDFormats[strings.ToUpper(country)]
+	if !ok {
+		panic("is: national identification numbers of country " + country + " are not supported")
+	}
+	return NationalIDRule{format: f, err: f.err}
+}
+
+// NationalIDRule is a validation rule that checks if a string is a valid national identification number.
+type NationalIDRule struct {
+	format      nationalIDFormat
+	allowMasked bool
+	err         valid.Error
+}
+
+// AllowMasked configures the rule to also accept partially redacted numbers, where all but the trailing part
+// are replaced by "X" or "*" characters while keeping the format, such as "XXX-XX-6789" (US), "***-***-544" (CA)
+// or "XX XX XX 56 C" (GB). A masked number cannot be checked any further, e.g. for its check digit.
+func (r NationalIDRule) AllowMasked() NationalIDRule {
+	r.allowMasked = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r NationalIDRule) Error(message string) NationalIDRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NationalIDRule) ErrorObject(err valid.Error) NationalIDRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NationalIDRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if r.format.validate(str) || r.allowMasked && r.format.masked.MatchString(str) {
+		return nil
+	}
+	return r.err
+}
+
+// isSIN checks if a string is a Canadian social insurance number. The first digit cannot be 0 or 8,
+// which are not assigned, and the last digit must be the Luhn check digit.
+func isSIN(str string) bool {
+	m := reSIN.FindStringSubmatch(str)
+	if m == nil || m[1] != m[2] {
+		return false
+	}
+	digits := strings.NewReplacer("-", "", " ", "").Replace(str)
+	if digits[0] == '0' || digits[0] == '8' {
+		return false
+	}
+	sum := 0
+	for i := range digits {
+		d := int(digits[i] - '0')
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isNINO checks if a string is a UK National Insurance number, excluding the prefixes that are not allocated.
+func isNINO(str string) bool {
+	if !reNINO.MatchString(str) {
+		return false
+	}
+	switch strings.ToUpper(str[:2]) {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
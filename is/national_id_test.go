@@ -0,0 +1,69 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNationalID(t *testing.T) {
+	const (
+		ssnErr  = "must be a valid social security number"
+		sinErr  = "must be a valid social insurance number"
+		ninoErr = "must be a valid National Insurance number"
+	)
+	tests := []struct {
+		tag   string
+		rule  NationalIDRule
+		value interface{}
+		err   string
+	}{
+		{"us1", SSN, "", ""},
+		{"us2", SSN, "123-45-6789", ""},
+		{"us3", NationalID("us"), "123 45 6789", ""},
+		{"us4", SSN, "123-456789", ssnErr},
+		{"us5", SSN, "XXX-XX-6789", ssnErr},
+		{"us6", SSN.AllowMasked(), "XXX-XX-6789", ""},
+		{"us7", SSN.AllowMasked(), "***-**-6789", ""},
+		{"us8", SSN.AllowMasked(), "xxx-xx-6789", ""},
+		{"us9", SSN.AllowMasked(), "XXX-XX-XXXX", ssnErr},
+		{"us10", SSN.AllowMasked(), "123-45-6789", ""},
+		{"us11", SSN, 123456789, "must be either a string or byte slice"},
+		{"ca1", NationalID("CA"), "", ""},
+		{"ca2", NationalID("CA"), "130 692 544", ""},
+		{"ca3", NationalID("CA"), "130-692-544", ""},
+		{"ca4", NationalID("CA"), "130692544", ""},
+		{"ca5", NationalID("CA"), "130 692 545", sinErr},
+		{"ca6", NationalID("CA"), "130-692 544", sinErr},
+		{"ca7", NationalID("CA"), "046 454 286", sinErr},
+		{"ca8", NationalID("CA"), "800000002", sinErr},
+		{"ca9", NationalID("CA"), "XXX-XXX-286", sinErr},
+		{"ca10", NationalID("CA").AllowMasked(), "XXX-XXX-286", ""},
+		{"ca11", NationalID("CA").AllowMasked(), "XXX-XX-6789", sinErr},
+		{"gb1", NationalID("GB"), "", ""},
+		{"gb2", NationalID("GB"), "AB 12 34 56 C", ""},
+		{"gb3", NationalID("GB"), "ab123456c", ""},
+		{"gb4", NationalID("GB"), "GB 12 34 56 C", ninoErr},
+		{"gb5", NationalID("GB"), "DA 12 34 56 C", ninoErr},
+		{"gb6", NationalID("GB"), "AB 12 34 56 E", ninoErr},
+		{"gb7", NationalID("GB").AllowMasked(), "XX XX XX 56 C", ""},
+		{"gb8", NationalID("GB").AllowMasked(), "XX XX XX XX C", ninoErr},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := NationalID("CA").Validate("130 692 545")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_sin", err.(valid.Error).Code())
+	}
+	assert.EqualError(t, SSN.Error("bad SSN").Validate("123"), "bad SSN")
+	assert.Panics(t, func() { NationalID("XY") })
+}
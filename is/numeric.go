@@ -0,0 +1,142 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrNumeric is the error that returns in case of a string that is not a number.
+	ErrNumeric = valid.NewError("validation_is_numeric", "must be a number")
+	// ErrNumericPositive is the error that returns in case of a number that is not positive when it is required.
+	ErrNumericPositive = valid.NewError("validation_is_numeric_positive", "must be a positive number")
+	// ErrNumericInteger is the error that returns in case of a number that is not an integer when it is required.
+	ErrNumericInteger = valid.NewError("validation_is_numeric_integer", "must be a whole number")
+)
+
+// numericRegexp matches a decimal number with an optional sign, fraction and exponent,
+// capturing the sign, the integer part, the fraction and the exponent.
+var numericRegexp = regexp.MustCompile(`^([+-]?)([0-9]*)(?:\.([0-9]*))?(?:[eE]([+-]?[0-9]+))?$`)
+
+// maxNumericExponent bounds the exponent of a number so that the arithmetic on it cannot overflow.
+const maxNumericExponent = 1 << 30
+
+// Numeric validates if a string is a decimal number, which may be an integer (e.g. "-42"), a decimal (e.g. "+1.25",
+// ".5" or "3.") or in scientific notation (e.g. "1.2e-3"). Unlike Int and Float, it accepts any of these forms.
+// Hexadecimal numbers, digit separators, "NaN" and "Inf" are rejected.
+// Call Positive() to only accept numbers greater than zero and Integer() to only accept numbers without
+// a fractional part (e.g. "12", "1.20e1" but not "1.25"). The numbers are checked exactly, without converting them
+// to floating point numbers, so the constraints hold even for numbers that a float64 cannot represent.
+var Numeric = NumericRule{err: ErrNumeric, positiveErr: ErrNumericPositive, integerErr: ErrNumericInteger}
+
+// NumericRule is a validation rule that checks if a string is a decimal number.
+type NumericRule struct {
+	positive    bool
+	integer     bool
+	err         valid.Error
+	positiveErr valid.Error
+	integerErr  valid.Error
+}
+
+// Positive configures the rule to only accept numbers greater than zero.
+func (r NumericRule) Positive() NumericRule {
+	r.positive = true
+	return r
+}
+
+// Integer configures the rule to only accept numbers without a fractional part.
+func (r NumericRule) Integer() NumericRule {
+	r.integer = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r NumericRule) Error(message string) NumericRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NumericRule) ErrorObject(err valid.Error) NumericRule {
+	r.err = err
+	return r
+}
+
+// PositiveError sets the error message returned when a number is not positive.
+func (r NumericRule) PositiveError(message string) NumericRule {
+	r.positiveErr = r.positiveErr.SetMessage(message)
+	return r
+}
+
+// PositiveErrorObject sets the error struct returned when a number is not positive.
+func (r NumericRule) PositiveErrorObject(err valid.Error) NumericRule {
+	r.positiveErr = err
+	return r
+}
+
+// IntegerError sets the error message returned when a number is not an integer.
+func (r NumericRule) IntegerError(message string) NumericRule {
+	r.integerErr = r.integerErr.SetMessage(message)
+	return r
+}
+
+// IntegerErrorObject sets the error struct returned when a number is not an integer.
+func (r NumericRule) IntegerErrorObject(err valid.Error) NumericRule {
+	r.integerErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NumericRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	m := numericRegexp.FindStringSubmatch(str)
+	if m == nil || m[2] == "" && m[3] == "" {
+		return r.err
+	}
+	sign, whole, frac := m[1], m[2], m[3]
+	zero := strings.Trim(whole+frac, "0") == ""
+	if r.positive && (zero || sign == "-") {
+		return r.positiveErr
+	}
+	if r.integer && !zero && !isIntegral(whole, frac, m[4]) {
+		return r.integerErr
+	}
+	return nil
+}
+
+// isIntegral reports whether the non-zero number made of the given integer part, fraction and exponent
+// has no fractional part.
+func isIntegral(whole, frac, exp string) bool {
+	e := 0
+	if exp != "" {
+		// the syntax is already checked, so an error can only be a range error that clamps e
+		e, _ = strconv.Atoi(exp)
+		if e > maxNumericExponent {
+			e = maxNumericExponent
+		} else if e < -maxNumericExponent {
+			e = -maxNumericExponent
+		}
+	}
+	frac = strings.TrimRight(frac, "0")
+	// the number of decimal places left after applying the exponent
+	places := len(frac) - e
+	if places <= 0 {
+		return true
+	}
+	if frac != "" {
+		return false
+	}
+	// the trailing zeros of the integer part can absorb a negative exponent, e.g. "100e-2"
+	return len(whole)-len(strings.TrimRight(whole, "0")) >= places
+}
@@ -0,0 +1,81 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumeric(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  NumericRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Numeric, "", ""},
+		{"t1.2", Numeric, "42", ""},
+		{"t1.3", Numeric, "-42", ""},
+		{"t1.4", Numeric, "+1.25", ""},
+		{"t1.5", Numeric, ".5", ""},
+		{"t1.6", Numeric, "3.", ""},
+		{"t1.7", Numeric, "1.2e-3", ""},
+		{"t1.8", Numeric, "-6.02E+23", ""},
+		{"t1.9", Numeric, "1e400", ""},
+		{"t1.10", Numeric, []byte("7"), ""},
+		{"t1.11", Numeric, ".", "must be a number"},
+		{"t1.12", Numeric, "-", "must be a number"},
+		{"t1.13", Numeric, "e5", "must be a number"},
+		{"t1.14", Numeric, "1e", "must be a number"},
+		{"t1.15", Numeric, "1.2.3", "must be a number"},
+		{"t1.16", Numeric, " 1", "must be a number"},
+		{"t1.17", Numeric, "0x1F", "must be a number"},
+		{"t1.18", Numeric, "1_000", "must be a number"},
+		{"t1.19", Numeric, "NaN", "must be a number"},
+		{"t1.20", Numeric, "Inf", "must be a number"},
+		{"t1.21", Numeric, 123, "must be either a string or byte slice"},
+		{"t2.1", Numeric.Positive(), "1", ""},
+		{"t2.2", Numeric.Positive(), "+0.001", ""},
+		{"t2.3", Numeric.Positive(), "1e-400", ""},
+		{"t2.4", Numeric.Positive(), "0", "must be a positive number"},
+		{"t2.5", Numeric.Positive(), "-0.0", "must be a positive number"},
+		{"t2.6", Numeric.Positive(), "0e10", "must be a positive number"},
+		{"t2.7", Numeric.Positive(), "-1", "must be a positive number"},
+		{"t2.8", Numeric.Positive(), "abc", "must be a number"},
+		{"t3.1", Numeric.Integer(), "12", ""},
+		{"t3.2", Numeric.Integer(), "-12.000", ""},
+		{"t3.3", Numeric.Integer(), "1.20e1", ""},
+		{"t3.4", Numeric.Integer(), "1.5e1", ""},
+		{"t3.5", Numeric.Integer(), "100e-2", ""},
+		{"t3.6", Numeric.Integer(), "0.0", ""},
+		{"t3.7", Numeric.Integer(), "1e99999999999", ""},
+		{"t3.8", Numeric.Integer(), "1.25", "must be a whole number"},
+		{"t3.9", Numeric.Integer(), "1.25e1", "must be a whole number"},
+		{"t3.10", Numeric.Integer(), "100e-3", "must be a whole number"},
+		{"t3.11", Numeric.Integer(), "5e-99999999999", "must be a whole number"},
+		{"t3.12", Numeric.Integer(), "1.0000000000000000000001", "must be a whole number"},
+		{"t4.1", Numeric.Positive().Integer(), "3", ""},
+		{"t4.2", Numeric.Positive().Integer(), "-3", "must be a positive number"},
+		{"t4.3", Numeric.Positive().Integer(), "0.5", "must be a whole number"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Numeric.Validate("abc")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_numeric", err.(valid.Error).Code())
+	}
+	assert.EqualError(t, Numeric.Error("not a number").Validate("abc"), "not a number")
+	assert.EqualError(t, Numeric.Positive().PositiveError("too small").Validate("0"), "too small")
+	assert.EqualError(t, Numeric.Integer().IntegerError("no decimals").Validate("0.5"), "no decimals")
+	err = Numeric.Integer().IntegerErrorObject(valid.NewError("code", "msg")).Validate("0.5")
+	assert.Equal(t, "code", err.(valid.Error).Code())
+}
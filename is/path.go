@@ -0,0 +1,166 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrFilePath is the error that returns in case of an invalid file path.
+	ErrFilePath = valid.NewError("validation_is_file_path", "must be a valid file path")
+	// ErrUnixPath is the error that returns in case of an invalid Unix file path.
+	ErrUnixPath = valid.NewError("validation_is_unix_path", "must be a valid Unix file path")
+	// ErrWindowsPath is the error that returns in case of an invalid Windows file path.
+	ErrWindowsPath = valid.NewError("validation_is_windows_path", "must be a valid Windows file path")
+	// ErrAbsolutePath is the error that returns in case of a file path that is not absolute.
+	ErrAbsolutePath = valid.NewError("validation_is_absolute_path", "must be an absolute file path")
+)
+
+const (
+	portablePath = iota
+	unixPath
+	windowsPath
+)
+
+var (
+	// FilePath validates if a string is a file path that is valid on both Unix and Windows.
+	// Both "/" and "\" are accepted as separators, and the characters and the file names reserved by Windows are rejected.
+	// A path is considered absolute if it is absolute on either platform (e.g. "/etc/hosts" or "C:\Windows").
+	FilePath = FilePathRule{style: portablePath, err: ErrFilePath, absErr: ErrAbsolutePath}
+	// UnixPath validates if a string is a valid Unix file path, i.e. a path that contains no null bytes.
+	UnixPath = FilePathRule{style: unixPath, err: ErrUnixPath, absErr: ErrAbsolutePath}
+	// WindowsPath validates if a string is a valid Windows file path, including drive-letter paths
+	// (C:\dir\file), UNC paths (\\server\share\file) and relative paths.
+	WindowsPath = FilePathRule{style: windowsPath, err: ErrWindowsPath, absErr: ErrAbsolutePath}
+)
+
+// windowsReservedNames lists the device names that cannot be used as a Windows file name.
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// FilePathRule is a validation rule that checks the syntax of a file path.
+// The rule never accesses the file system, so it does not check if the file exists.
+type FilePathRule struct {
+	style       int
+	absolute    bool
+	err, absErr valid.Error
+}
+
+// MustBeAbsolute configures the rule to only accept absolute paths.
+func (r FilePathRule) MustBeAbsolute() FilePathRule {
+	r.absolute = true
+	return r
+}
+
+// Error sets the error message that is used when the value being validated is not a valid path.
+func (r FilePathRule) Error(message string) FilePathRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value being validated is not a valid path.
+func (r FilePathRule) ErrorObject(err valid.Error) FilePathRule {
+	r.err = err
+	return r
+}
+
+// AbsoluteError sets the error message that is used when the value being validated is not an absolute path.
+func (r FilePathRule) AbsoluteError(message string) FilePathRule {
+	r.absErr = r.absErr.SetMessage(message)
+	return r
+}
+
+// AbsoluteErrorObject sets the error struct that is used when the value being validated is not an absolute path.
+func (r FilePathRule) AbsoluteErrorObject(err valid.Error) FilePathRule {
+	r.absErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r FilePathRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	var abs bool
+	switch r.style {
+	case unixPath:
+		ok, abs = !strings.ContainsRune(str, 0), str[0] == '/'
+	case windowsPath:
+		ok, abs = checkWindowsPath(str)
+	default:
+		ok, abs = checkWindowsPath(str)
+		abs = abs || str[0] == '/' && (len(str) == 1 || str[1] != '/')
+	}
+
+	if !ok {
+		return r.err
+	}
+	if r.absolute && !abs {
+		return r.absErr
+	}
+	return nil
+}
+
+// checkWindowsPath checks if the given path is a valid Windows path and if it is absolute.
+func checkWindowsPath(path string) (valid bool, absolute bool) {
+	rest := path
+	if len(path) >= 2 && isASCIILetter(path[0]) && path[1] == ':' {
+		// a drive letter path, e.g. C:\dir or C:dir
+		rest = path[2:]
+		absolute = rest != "" && isPathSeparator(rest[0])
+	} else if len(path) >= 2 && isPathSeparator(path[0]) && isPathSeparator(path[1]) {
+		// a UNC path, e.g. \\server\share\dir
+		rest = path[2:]
+		if len(splitPath(rest)) < 2 || rest == "" || isPathSeparator(rest[0]) {
+			return false, false
+		}
+		absolute = true
+	}
+
+	for _, name := range splitPath(rest) {
+		if !isWindowsFileName(name) {
+			return false, false
+		}
+	}
+	return true, absolute
+}
+
+// isWindowsFileName checks if the given path component is a valid Windows file or directory name.
+func isWindowsFileName(name string) bool {
+	if name == "." || name == ".." {
+		return true
+	}
+	for _, c := range name {
+		if c < 32 || strings.ContainsRune(`<>:"|?*`, c) {
+			return false
+		}
+	}
+	base := name
+	if i := strings.IndexByte(base, '.'); i >= 0 {
+		base = base[:i]
+	}
+	return !windowsReservedNames[strings.ToUpper(strings.TrimRight(base, " "))]
+}
+
+// splitPath splits a path into its non-empty components.
+func splitPath(path string) []string {
+	return strings.FieldsFunc(path, func(c rune) bool { return c == '/' || c == '\\' })
+}
+
+func isPathSeparator(c byte) bool {
+	return c == '/' || c == '\\'
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
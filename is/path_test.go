@@ -0,0 +1,59 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilePath(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  FilePathRule
+		value string
+		err   string
+	}{
+		{"unix1", UnixPath, "", ""},
+		{"unix2", UnixPath, "/etc/hosts", ""},
+		{"unix3", UnixPath, "relative/dir/file:name?.txt", ""},
+		{"unix4", UnixPath, "/etc/ho\x00sts", "must be a valid Unix file path"},
+		{"unix5", UnixPath.MustBeAbsolute(), "/etc/hosts", ""},
+		{"unix6", UnixPath.MustBeAbsolute(), "etc/hosts", "must be an absolute file path"},
+		{"win1", WindowsPath, `C:\Windows\System32`, ""},
+		{"win2", WindowsPath, `C:/Program Files/app.exe`, ""},
+		{"win3", WindowsPath, `dir\file.txt`, ""},
+		{"win4", WindowsPath, `\\server\share\file.txt`, ""},
+		{"win5", WindowsPath, `..\file.txt`, ""},
+		{"win6", WindowsPath, `C:\dir\fi|le.txt`, "must be a valid Windows file path"},
+		{"win7", WindowsPath, `C:\dir\a:b`, "must be a valid Windows file path"},
+		{"win8", WindowsPath, `dir\NUL.txt`, "must be a valid Windows file path"},
+		{"win9", WindowsPath, "dir\\file\x01", "must be a valid Windows file path"},
+		{"win10", WindowsPath, `\\server`, "must be a valid Windows file path"},
+		{"win11", WindowsPath, `1:\dir`, "must be a valid Windows file path"},
+		{"win12", WindowsPath.MustBeAbsolute(), `C:\dir`, ""},
+		{"win13", WindowsPath.MustBeAbsolute(), `\\server\share`, ""},
+		{"win14", WindowsPath.MustBeAbsolute(), `C:dir`, "must be an absolute file path"},
+		{"win15", WindowsPath.MustBeAbsolute(), `\dir`, "must be an absolute file path"},
+		{"any1", FilePath, "/etc/hosts", ""},
+		{"any2", FilePath, `C:\Windows`, ""},
+		{"any3", FilePath, "dir/file.txt", ""},
+		{"any4", FilePath, "dir/file?.txt", "must be a valid file path"},
+		{"any5", FilePath, "dir/aux", "must be a valid file path"},
+		{"any6", FilePath.MustBeAbsolute(), "/etc/hosts", ""},
+		{"any7", FilePath.MustBeAbsolute(), `D:\data`, ""},
+		{"any8", FilePath.MustBeAbsolute(), "dir/file.txt", "must be an absolute file path"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, FilePath.Error("bad path").Validate("a|b"), "bad path")
+	assert.EqualError(t, FilePath.MustBeAbsolute().AbsoluteError("not absolute").Validate("a"), "not absolute")
+	assert.EqualError(t, UnixPath.Validate(123), "must be either a string or byte slice")
+}
@@ -0,0 +1,158 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrE164CountryCode is the error that returns in case of an E164 number with an unknown country calling code.
+	ErrE164CountryCode = valid.NewError("validation_is_e164_country_code", "must have a known country calling code")
+	// ErrE164Length is the error that returns in case of an E164 number whose length is not plausible for its country.
+	ErrE164Length = valid.NewError("validation_is_e164_length", "must have a valid length for country calling code {{.code}}")
+)
+
+// E164 validates if a string is a valid E164 phone number (e.g. +19251232233), i.e. up to 15 digits that do not
+// start with 0, optionally preceded by "+". Call RequireKnownCountry() to also check the country calling code.
+var E164 = E164Rule{err: ErrE164, countryErr: ErrE164CountryCode, lengthErr: ErrE164Length}
+
+// E164Rule is a validation rule that checks if a string is a valid E164 phone number.
+type E164Rule struct {
+	knownCountry bool
+	err          valid.Error
+	countryErr   valid.Error
+	lengthErr    valid.Error
+}
+
+// RequireKnownCountry configures the rule to only accept numbers starting with an assigned country calling code
+// and followed by a national number of a plausible length for that code, e.g. it rejects +9991234 whose code 999
+// is not assigned. The national number lengths are only known for the most common codes; the numbers of the other
+// codes are only checked against the overall limit of 15 digits.
+func (r E164Rule) RequireKnownCountry() E164Rule {
+	r.knownCountry = true
+	return r
+}
+
+// Error sets the error message returned when the value is not a valid E164 number.
+func (r E164Rule) Error(message string) E164Rule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct returned when the value is not a valid E164 number.
+func (r E164Rule) ErrorObject(err valid.Error) E164Rule {
+	r.err = err
+	return r
+}
+
+// CountryCodeError sets the error message returned when the country calling code is unknown.
+func (r E164Rule) CountryCodeError(message string) E164Rule {
+	r.countryErr = r.countryErr.SetMessage(message)
+	return r
+}
+
+// CountryCodeErrorObject sets the error struct returned when the country calling code is unknown.
+func (r E164Rule) CountryCodeErrorObject(err valid.Error) E164Rule {
+	r.countryErr = err
+	return r
+}
+
+// LengthError sets the error message returned when the length of the number is not plausible for its country.
+func (r E164Rule) LengthError(message string) E164Rule {
+	r.lengthErr = r.lengthErr.SetMessage(message)
+	return r
+}
+
+// LengthErrorObject sets the error struct returned when the length of the number is not plausible for its country.
+func (r E164Rule) LengthErrorObject(err valid.Error) E164Rule {
+	r.lengthErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r E164Rule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if !isE164Number(str) {
+		return r.err
+	}
+	if !r.knownCountry {
+		return nil
+	}
+
+	digits := strings.TrimPrefix(str, "+")
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		code := digits[:n]
+		lengths, ok := countryCallingCodes[code]
+		if !ok {
+			continue
+		}
+		if l := len(digits) - n; l < lengths[0] || l > lengths[1] {
+			return r.lengthErr.SetParams(map[string]interface{}{"code": code})
+		}
+		return nil
+	}
+	return r.countryErr
+}
+
+// nationalNumberLengths lists the plausible national number lengths of the most common country calling codes.
+// The other codes accept any national number fitting within the 15 digits of an E164 number.
+var nationalNumberLengths = map[string][2]int{
+	"1": {10, 10}, "7": {10, 10},
+	"20": {8, 10}, "27": {9, 9}, "30": {10, 10}, "31": {9, 9}, "32": {8, 9}, "33": {9, 9}, "34": {9, 9},
+	"36": {8, 9}, "39": {6, 11}, "40": {9, 9}, "41": {9, 9}, "43": {4, 13}, "44": {7, 10}, "45": {8, 8},
+	"46": {6, 10}, "47": {5, 8}, "48": {9, 9}, "49": {4, 13}, "51": {8, 9}, "52": {10, 10}, "53": {6, 8},
+	"54": {10, 11}, "55": {10, 11}, "56": {9, 9}, "57": {8, 10}, "58": {10, 10}, "60": {8, 10}, "61": {9, 9},
+	"62": {7, 12}, "63": {8, 10}, "64": {8, 10}, "65": {8, 8}, "66": {8, 9}, "81": {9, 10}, "82": {7, 10},
+	"84": {9, 10}, "86": {7, 12}, "90": {10, 10}, "91": {10, 10}, "92": {8, 11}, "93": {9, 9}, "94": {9, 9},
+	"95": {7, 10}, "98": {10, 10},
+	"212": {9, 9}, "213": {8, 9}, "216": {8, 8}, "218": {9, 9}, "234": {8, 10}, "254": {9, 9}, "255": {9, 9},
+	"256": {9, 9}, "351": {9, 9}, "352": {4, 11}, "353": {7, 10}, "354": {7, 9}, "358": {5, 12}, "370": {8, 8},
+	"371": {8, 8}, "372": {7, 8}, "380": {9, 9}, "420": {9, 9}, "421": {9, 9}, "852": {8, 8}, "853": {8, 8},
+	"880": {8, 10}, "886": {8, 9}, "961": {7, 8}, "962": {8, 9}, "966": {9, 9}, "971": {8, 9}, "972": {8, 9},
+	"974": {8, 8},
+}
+
+// countryCallingCodes lists the assigned ITU-T E.164 country calling codes with the minimum and maximum lengths of
+// their national (significant) numbers. The codes are prefix-free, so a number matches at most one of them.
+var countryCallingCodes = buildCountryCallingCodes(
+	"1", "7",
+	"20", "27", "30", "31", "32", "33", "34", "36", "39", "40", "41", "43", "44", "45", "46", "47", "48", "49",
+	"51", "52", "53", "54", "55", "56", "57", "58", "60", "61", "62", "63", "64", "65", "66", "81", "82", "84",
+	"86", "90", "91", "92", "93", "94", "95", "98",
+	"211", "212", "213", "216", "218", "220", "221", "222", "223", "224", "225", "226", "227", "228", "229",
+	"230", "231", "232", "233", "234", "235", "236", "237", "238", "239", "240", "241", "242", "243", "244",
+	"245", "246", "247", "248", "249", "250", "251", "252", "253", "254", "255", "256", "257", "258", "260",
+	"261", "262", "263", "264", "265", "266", "267", "268", "269", "290", "291", "297", "298", "299",
+	"350", "351", "352", "353", "354", "355", "356", "357", "358", "359", "370", "371", "372", "373", "374",
+	"375", "376", "377", "378", "379", "380", "381", "382", "383", "385", "386", "387", "389",
+	"420", "421", "423",
+	"500", "501", "502", "503", "504", "505", "506", "507", "508", "509", "590", "591", "592", "593", "594",
+	"595", "596", "597", "598", "599",
+	"670", "672", "673", "674", "675", "676", "677", "678", "679", "680", "681", "682", "683", "685", "686",
+	"687", "688", "689", "690", "691", "692",
+	"800", "808", "850", "852", "853", "855", "856", "870", "878", "880", "881", "882", "883", "886", "888",
+	"960", "961", "962", "963", "964", "965", "966", "967", "968", "970", "971", "972", "973", "974", "975",
+	"976", "977", "979", "992", "993", "994", "995", "996", "998",
+)
+
+// buildCountryCallingCodes returns the national number lengths of the given country calling codes.
+func buildCountryCallingCodes(codes ...string) map[string][2]int {
+	m := make(map[string][2]int, len(codes))
+	for _, code := range codes {
+		lengths, ok := nationalNumberLengths[code]
+		if !ok {
+			lengths = [2]int{1, 15 - len(code)}
+		}
+		m[code] = lengths
+	}
+	return m
+}
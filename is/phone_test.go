@@ -0,0 +1,63 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestE164(t *testing.T) {
+	known := E164.RequireKnownCountry()
+	tests := []struct {
+		tag   string
+		rule  E164Rule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", E164, "", ""},
+		{"t1.2", E164, "+19251232233", ""},
+		{"t1.3", E164, "19251232233", ""},
+		{"t1.4", E164, "+9991234", ""},
+		{"t1.5", E164, "+00124222333", "must be a valid E164 number"},
+		{"t1.6", E164, "+1925123223312345", "must be a valid E164 number"},
+		{"t1.7", E164, 123, "must be either a string or byte slice"},
+		{"t2.1", known, "+19251232233", ""},
+		{"t2.2", known, "+442079460000", ""},
+		{"t2.3", known, []byte("+4915123456789"), ""},
+		{"t2.4", known, "+35312345678", ""},
+		{"t2.5", known, "+2981234", ""},
+		{"t2.6", known, "+9991234", "must have a known country calling code"},
+		{"t2.7", known, "+2101234567", "must have a known country calling code"},
+		{"t2.8", known, "+1925123", "must have a valid length for country calling code 1"},
+		{"t2.9", known, "+3312345678901", "must have a valid length for country calling code 33"},
+		{"t2.10", known, "+00124222333", "must be a valid E164 number"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := known.Error("bad format").CountryCodeError("bad country").LengthError("bad length")
+	assert.EqualError(t, r.Validate("abc"), "bad format")
+	assert.EqualError(t, r.Validate("+9991234"), "bad country")
+	assert.EqualError(t, r.Validate("+1925"), "bad length")
+	assert.Equal(t, "validation_is_e164_country_code", ErrE164CountryCode.Code())
+}
+
+func TestCountryCallingCodes(t *testing.T) {
+	for code, lengths := range countryCallingCodes {
+		assert.True(t, lengths[0] <= lengths[1] && len(code)+lengths[1] <= 15, code)
+		for other := range countryCallingCodes {
+			assert.False(t, other != code && strings.HasPrefix(other, code), "%v is a prefix of %v", code, other)
+		}
+	}
+	for code := range nationalNumberLengths {
+		assert.Contains(t, countryCallingCodes, code)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrPostalCode is the error that returns in case of an invalid postal code of a country with a known format.
+	ErrPostalCode = valid.NewError("validation_is_postal_code", "must be a valid postal code (e.g. {{.example}})")
+	// ErrPostalCodeUnknownCountry is the error that returns in case of an invalid postal code of a country
+	// whose format is unknown.
+	ErrPostalCodeUnknownCountry = valid.NewError("validation_is_postal_code", "must be a valid postal code")
+)
+
+type postalCodeFormat struct {
+	re      *regexp.Regexp
+	example string
+}
+
+// postalCodeFormats lists the postal code formats indexed by the ISO 3166-1 alpha-2 country codes.
+var postalCodeFormats = map[string]postalCodeFormat{
+	"AT": {regexp.MustCompile(`^\d{4}$`), "1234"},
+	"AU": {regexp.MustCompile(`^\d{4}$`), "1234"},
+	"BE": {regexp.MustCompile(`^\d{4}$`), "1234"},
+	"BR": {regexp.MustCompile(`^\d{5}-?\d{3}$`), "12345-678"},
+	"CA": {regexp.MustCompile(`(?i)^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d$`), "A1A 1A1"},
+	"CH": {regexp.MustCompile(`^\d{4}$`), "1234"},
+	"CN": {regexp.MustCompile(`^\d{6}$`), "123456"},
+	"DE": {regexp.MustCompile(`^\d{5}$`), "12345"},
+	"DK": {regexp.MustCompile(`^\d{4}$`), "1234"},
+	"ES": {regexp.MustCompile(`^(?:0[1-9]|[1-4]\d|5[0-2])\d{3}$`), "12345"},
+	"FR": {regexp.MustCompile(`^\d{5}$`), "12345"},
+	"GB": {regexp.MustCompile(`(?i)^(?:GIR ?0AA|[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2})$`), "SW1A 1AA"},
+	"IE": {regexp.MustCompile(`(?i)^(?:[AC-FHKNPRTV-Y]\d{2}|D6W) ?[0-9AC-FHKNPRTV-Y]{4}$`), "A65 F4E2"},
+	"IN": {regexp.MustCompile(`^[1-9]\d{5}$`), "123456"},
+	"IT": {regexp.MustCompile(`^\d{5}$`), "12345"},
+	"JP": {regexp.MustCompile(`^\d{3}-?\d{4}$`), "123-4567"},
+	"KR": {regexp.MustCompile(`^\d{5}$`), "12345"},
+	"MX": {regexp.MustCompile(`^\d{5}$`), "12345"},
+	"NL": {regexp.MustCompile(`(?i)^[1-9]\d{3} ?[A-Z]{2}$`), "1234 AB"},
+	"NO": {regexp.MustCompile(`^\d{4}$`), "1234"},
+	"PL": {regexp.MustCompile(`^\d{2}-\d{3}$`), "12-345"},
+	"PT": {regexp.MustCompile(`^\d{4}-\d{3}$`), "1234-567"},
+	"RU": {regexp.MustCompile(`^\d{6}$`), "123456"},
+	"SE": {regexp.MustCompile(`^\d{3} ?\d{2}$`), "123 45"},
+	"US": {regexp.MustCompile(`^\d{5}(?:-\d{4})?$`), "12345 or 12345-6789"},
+}
+
+// rePostalCode is used for the countries whose postal code format is unknown.
+var rePostalCode = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9 -]{0,8}[A-Za-z0-9])?$`)
+
+// PostalCode returns a validation rule that checks if a string is a valid postal code of the given country,
+// specified as an ISO 3166-1 alpha-2 code (case-insensitive).
+// The formats of the following countries are built in: AT, AU, BE, BR, CA, CH, CN, DE, DK, ES, FR, GB, IE, IN,
+// IT, JP, KR, MX, NL, NO, PL, PT, RU, SE and US. For any other country, the rule accepts up to 10 letters,
+// digits, spaces and hyphens, starting and ending with a letter or a digit.
+// The error message of a built-in format includes an example of a valid postal code.
+func PostalCode(country string) PostalCodeRule {
+	if f, ok := postalCodeFormats[strings.ToUpper(country)]; ok {
+		return PostalCodeRule{
+			re:  f.re,
+			err: ErrPostalCode.SetParams(map[string]interface{}{"example": f.example}),
+		}
+	}
+	return PostalCodeRule{re: rePostalCode, err: ErrPostalCodeUnknownCountry}
+}
+
+// PostalCodeRule is a validation rule that checks if a string is a valid postal code.
+type PostalCodeRule struct {
+	re  *regexp.Regexp
+	err valid.Error
+}
+
+// Error sets the error message for the rule.
+func (r PostalCodeRule) Error(message string) PostalCodeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PostalCodeRule) ErrorObject(err valid.Error) PostalCodeRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r PostalCodeRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if !r.re.MatchString(str) {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostalCode(t *testing.T) {
+	tests := []struct {
+		tag     string
+		country string
+		value   interface{}
+		err     string
+	}{
+		{"t1.1", "US", "", ""},
+		{"t1.2", "US", "12345", ""},
+		{"t1.3", "US", "12345-6789", ""},
+		{"t1.4", "us", "12345", ""},
+		{"t1.5", "US", "1234", "must be a valid postal code (e.g. 12345 or 12345-6789)"},
+		{"t1.6", "US", "12345-67", "must be a valid postal code (e.g. 12345 or 12345-6789)"},
+		{"t1.7", "US", 12345, "must be either a string or byte slice"},
+		{"t2.1", "CA", "K1A 0B1", ""},
+		{"t2.2", "CA", "k1a0b1", ""},
+		{"t2.3", "CA", "D1A 0B1", "must be a valid postal code (e.g. A1A 1A1)"},
+		{"t3.1", "GB", "SW1A 1AA", ""},
+		{"t3.2", "GB", "M1 1AE", ""},
+		{"t3.3", "GB", "GIR 0AA", ""},
+		{"t3.4", "GB", "SW1A", "must be a valid postal code (e.g. SW1A 1AA)"},
+		{"t4.1", "DE", "10115", ""},
+		{"t4.2", "DE", "1011", "must be a valid postal code (e.g. 12345)"},
+		{"t5.1", "JP", "100-0001", ""},
+		{"t5.2", "JP", "1000001", ""},
+		{"t5.3", "JP", "100-001", "must be a valid postal code (e.g. 123-4567)"},
+		{"t6.1", "NL", "1012 AB", ""},
+		{"t6.2", "NL", "0123 AB", "must be a valid postal code (e.g. 1234 AB)"},
+		{"t7.1", "PL", "00-950", ""},
+		{"t7.2", "IE", "D6W 1234", ""},
+		{"t7.3", "ES", "53001", "must be a valid postal code (e.g. 12345)"},
+		{"t8.1", "XX", "AB 123", ""},
+		{"t8.2", "", "1234-56", ""},
+		{"t8.3", "XX", "AB_123", "must be a valid postal code"},
+		{"t8.4", "XX", "12345678901", "must be a valid postal code"},
+		{"t8.5", "XX", " 123", "must be a valid postal code"},
+		{"t8.6", "XX", []byte("1"), ""},
+	}
+
+	for _, test := range tests {
+		err := PostalCode(test.country).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, PostalCode("US").Error("bad zip").Validate("1"), "bad zip")
+	// the examples shown in the error messages must be valid
+	for country, f := range postalCodeFormats {
+		for _, example := range strings.Split(f.example, " or ") {
+			assert.Nil(t, PostalCode(country).Validate(example), country)
+		}
+	}
+}
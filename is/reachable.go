@@ -0,0 +1,169 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrURLNotAllowed is the error that returns in case of a URL rejected by the allowlist of URLReachable.
+	ErrURLNotAllowed = valid.NewError("validation_is_url_not_allowed", "must be an allowed URL")
+	// ErrURLUnreachable is the error that returns in case of a URL that cannot be reached or responds with a non-2xx status.
+	ErrURLUnreachable = valid.NewError("validation_is_url_unreachable", "must be a reachable URL")
+)
+
+// errRedirectNotAllowed stops a redirect to a URL rejected by the allowlist of URLReachable.
+var errRedirectNotAllowed = errors.New("redirect to a URL that is not allowed")
+
+type httpClientKey struct{}
+
+// WithHTTPClient returns a copy of the context that makes URLReachable send its requests with the given client,
+// e.g. one with a timeout or a transport that refuses to dial private networks. Without it, http.DefaultClient is used.
+func WithHTTPClient(ctx context.Context, client *http.Client) context.Context {
+	return context.WithValue(ctx, httpClientKey{}, client)
+}
+
+// URLReachable returns a validation rule that checks if a string is an absolute http or https URL that responds
+// to a HEAD request with a 2xx status, e.g. a webhook URL configured by an administrator. The check is only made by
+// ValidateWithContext, so that the request honors the deadline of the context; Validate only checks the syntax,
+// like URL does.
+//
+// Validating a URL this way makes the server send a request to wherever the user points it, which can be abused
+// for server-side request forgery (SSRF), e.g. to probe internal services or cloud metadata endpoints. The allow
+// function must therefore be given to decide which URLs may be requested; it is also applied to every redirect.
+// A nil function allows no URL. Consider also passing a client restricted to public networks with WithHTTPClient.
+//
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func URLReachable(allow func(u *url.URL) bool) URLReachableRule {
+	return URLReachableRule{
+		allow:          allow,
+		err:            ErrURL,
+		notAllowedErr:  ErrURLNotAllowed,
+		unreachableErr: ErrURLUnreachable,
+	}
+}
+
+// URLReachableRule is a validation rule that checks if a string is a URL that responds with a 2xx status.
+type URLReachableRule struct {
+	allow          func(u *url.URL) bool
+	err            valid.Error
+	notAllowedErr  valid.Error
+	unreachableErr valid.Error
+}
+
+// Error sets the error message returned when the value is not a valid http or https URL.
+func (r URLReachableRule) Error(message string) URLReachableRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct returned when the value is not a valid http or https URL.
+func (r URLReachableRule) ErrorObject(err valid.Error) URLReachableRule {
+	r.err = err
+	return r
+}
+
+// NotAllowedError sets the error message returned when the URL is rejected by the allow function.
+func (r URLReachableRule) NotAllowedError(message string) URLReachableRule {
+	r.notAllowedErr = r.notAllowedErr.SetMessage(message)
+	return r
+}
+
+// NotAllowedErrorObject sets the error struct returned when the URL is rejected by the allow function.
+func (r URLReachableRule) NotAllowedErrorObject(err valid.Error) URLReachableRule {
+	r.notAllowedErr = err
+	return r
+}
+
+// UnreachableError sets the error message returned when the URL cannot be reached or responds with a non-2xx status.
+func (r URLReachableRule) UnreachableError(message string) URLReachableRule {
+	r.unreachableErr = r.unreachableErr.SetMessage(message)
+	return r
+}
+
+// UnreachableErrorObject sets the error struct returned when the URL cannot be reached or responds with a non-2xx status.
+func (r URLReachableRule) UnreachableErrorObject(err valid.Error) URLReachableRule {
+	r.unreachableErr = err
+	return r
+}
+
+// Validate checks if the given value is a valid http or https URL, without sending any request.
+func (r URLReachableRule) Validate(value interface{}) error {
+	_, err := r.parse(value)
+	return err
+}
+
+// ValidateWithContext checks if the given value is an allowed URL that responds to a HEAD request with a 2xx status.
+// An internal error is returned if the context is done before the response is received.
+func (r URLReachableRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	u, err := r.parse(value)
+	if u == nil {
+		return err
+	}
+	if r.allow == nil || !r.allow(u) {
+		return r.notAllowedErr
+	}
+
+	client, _ := ctx.Value(httpClientKey{}).(*http.Client)
+	if client == nil {
+		client = http.DefaultClient
+	}
+	c := *client
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !r.allow(req.URL) {
+			return errRedirectNotAllowed
+		}
+		if client.CheckRedirect != nil {
+			return client.CheckRedirect(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.String(), nil)
+	if err != nil {
+		return r.unreachableErr
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return valid.NewInternalError(ctx.Err())
+		}
+		if errors.Is(err, errRedirectNotAllowed) {
+			return r.notAllowedErr
+		}
+		return r.unreachableErr
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return r.unreachableErr
+	}
+	return nil
+}
+
+// parse returns the URL in the given value, or nil together with the error to return if it is empty or invalid.
+func (r URLReachableRule) parse(value interface{}) (*url.URL, error) {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return nil, err
+	}
+	if !govalidator.IsURL(str) {
+		return nil, r.err
+	}
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, r.err
+	}
+	return u, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLReachable(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusNoContent)
+		case "/redirect":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		case "/escape":
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		case "/slow":
+			time.Sleep(100 * time.Millisecond)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	host := server.Listener.Addr().String()
+	allow := func(u *url.URL) bool { return u.Host == host }
+
+	tests := []struct {
+		tag   string
+		rule  URLReachableRule
+		value interface{}
+		err   string
+	}{
+		{"t1", URLReachable(allow), "", ""},
+		{"t2", URLReachable(allow), server.URL + "/ok", ""},
+		{"t3", URLReachable(allow), []byte(server.URL + "/ok"), ""},
+		{"t4", URLReachable(allow), server.URL + "/redirect", ""},
+		{"t5", URLReachable(allow), server.URL + "/missing", "must be a reachable URL"},
+		{"t6", URLReachable(allow), server.URL + "/escape", "must be an allowed URL"},
+		{"t7", URLReachable(allow), "http://example.com/ok", "must be an allowed URL"},
+		{"t8", URLReachable(nil), server.URL + "/ok", "must be an allowed URL"},
+		{"t9", URLReachable(allow), "ftp://" + host + "/ok", "must be a valid URL"},
+		{"t10", URLReachable(allow), host + "/ok", "must be a valid URL"},
+		{"t11", URLReachable(allow), "not a url", "must be a valid URL"},
+		{"t12", URLReachable(func(*url.URL) bool { return true }), "http://127.0.0.1:1/", "must be a reachable URL"},
+		{"t13", URLReachable(allow), 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.ValidateWithContext(context.Background(), test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+	for _, m := range methods {
+		assert.Equal(t, http.MethodHead, m)
+	}
+
+	// no request is sent without a context
+	methods = nil
+	assert.Nil(t, URLReachable(allow).Validate(server.URL+"/missing"))
+	assert.Nil(t, valid.Validate(server.URL+"/missing", URLReachable(allow)))
+	assertError(t, "must be a valid URL", URLReachable(allow).Validate("ftp://"+host), "t14")
+	assert.Empty(t, methods)
+	err := valid.ValidateWithContext(context.Background(), server.URL+"/missing", URLReachable(allow))
+	assertError(t, "must be a reachable URL", err, "t15")
+}
+
+func TestURLReachable_Context(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Client") != "custom" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer server.Close()
+	r := URLReachable(func(*url.URL) bool { return true })
+
+	// the client in the context is used
+	assertError(t, "must be a reachable URL", r.ValidateWithContext(context.Background(), server.URL), "t1")
+	client := &http.Client{Transport: headerTransport{"X-Client", "custom"}}
+	assert.Nil(t, r.ValidateWithContext(WithHTTPClient(context.Background(), client), server.URL))
+
+	// the request honors the deadline of the context
+	ctx, cancel := context.WithTimeout(WithHTTPClient(context.Background(), client), 10*time.Millisecond)
+	defer cancel()
+	err := r.ValidateWithContext(ctx, server.URL)
+	if assert.NotNil(t, err) {
+		ie, ok := err.(valid.InternalError)
+		if assert.True(t, ok) {
+			assert.Equal(t, context.DeadlineExceeded, ie.InternalError())
+		}
+	}
+}
+
+func TestURLReachableRule_Error(t *testing.T) {
+	r := URLReachable(nil).Error("a").NotAllowedError("b").UnreachableError("c")
+	assert.Equal(t, "a", r.Validate("abc").Error())
+	assert.Equal(t, "b", r.ValidateWithContext(context.Background(), "http://example.com").Error())
+	assert.Equal(t, "c", r.unreachableErr.Message())
+
+	err := valid.NewError("code", "abc")
+	r = URLReachable(nil).ErrorObject(err).NotAllowedErrorObject(err).UnreachableErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.notAllowedErr)
+	assert.Equal(t, err, r.unreachableErr)
+}
+
+// headerTransport adds a header to every request.
+type headerTransport struct {
+	name, value string
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(t.name, t.value)
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -153,10 +153,6 @@ var (
 	LowerCase = valid.NewStringRuleWithError(govalidator.IsLowerCase, ErrLowerCase)
 	// UpperCase validates if a string contains upper case unicode letters only
 	UpperCase = valid.NewStringRuleWithError(govalidator.IsUpperCase, ErrUpperCase)
-	// Hexadecimal validates if a string is a valid hexadecimal number
-	Hexadecimal = valid.NewStringRuleWithError(govalidator.IsHexadecimal, ErrHexadecimal)
-	// HexColor validates if a string is a valid hexadecimal color code
-	HexColor = valid.NewStringRuleWithError(govalidator.IsHexcolor, ErrHexColor)
 	// RGBColor validates if a string is a valid RGB color in the form of rgb(R, G, B)
 	RGBColor = valid.NewStringRuleWithError(govalidator.IsRGBcolor, ErrRGBColor)
 	// Int validates if a string is a valid integer number
@@ -169,22 +165,16 @@ var (
 	UUIDv4 = valid.NewStringRuleWithError(govalidator.IsUUIDv4, ErrUUIDv4)
 	// UUIDv5 validates if a string is a valid version 5 UUID
 	UUIDv5 = valid.NewStringRuleWithError(govalidator.IsUUIDv5, ErrUUIDv5)
-	// UUID validates if a string is a valid UUID
-	UUID = valid.NewStringRuleWithError(govalidator.IsUUID, ErrUUID)
 	// CreditCard validates if a string is a valid credit card number
 	CreditCard = valid.NewStringRuleWithError(govalidator.IsCreditCard, ErrCreditCard)
 	// ISBN10 validates if a string is an ISBN version 10
 	ISBN10 = valid.NewStringRuleWithError(govalidator.IsISBN10, ErrISBN10)
 	// ISBN13 validates if a string is an ISBN version 13
 	ISBN13 = valid.NewStringRuleWithError(govalidator.IsISBN13, ErrISBN13)
-	// ISBN validates if a string is an ISBN (either version 10 or 13)
-	ISBN = valid.NewStringRuleWithError(isISBN, ErrISBN)
 	// JSON validates if a string is in valid JSON format
 	JSON = valid.NewStringRuleWithError(govalidator.IsJSON, ErrJSON)
 	// ASCII validates if a string contains ASCII characters only
 	ASCII = valid.NewStringRuleWithError(govalidator.IsASCII, ErrASCII)
-	// PrintableASCII validates if a string contains printable ASCII characters only
-	PrintableASCII = valid.NewStringRuleWithError(govalidator.IsPrintableASCII, ErrPrintableASCII)
 	// Multibyte validates if a string contains multibyte characters
 	Multibyte = valid.NewStringRuleWithError(govalidator.IsMultibyte, ErrMultibyte)
 	// FullWidth validates if a string contains full-width characters
@@ -197,14 +187,10 @@ var (
 	Base64 = valid.NewStringRuleWithError(govalidator.IsBase64, ErrBase64)
 	// DataURI validates if a string is a valid base64-encoded data URI
 	DataURI = valid.NewStringRuleWithError(govalidator.IsDataURI, ErrDataURI)
-	// E164 validates if a string is a valid ISO3166 Alpha 2 country code
-	E164 = valid.NewStringRuleWithError(isE164Number, ErrE164)
 	// CountryCode2 validates if a string is a valid ISO3166 Alpha 2 country code
 	CountryCode2 = valid.NewStringRuleWithError(govalidator.IsISO3166Alpha2, ErrCountryCode2)
 	// CountryCode3 validates if a string is a valid ISO3166 Alpha 3 country code
 	CountryCode3 = valid.NewStringRuleWithError(govalidator.IsISO3166Alpha3, ErrCountryCode3)
-	// CurrencyCode validates if a string is a valid IsISO4217 currency code.
-	CurrencyCode = valid.NewStringRuleWithError(govalidator.IsISO4217, ErrCurrencyCode)
 	// DialString validates if a string is a valid dial string that can be passed to Dial()
 	DialString = valid.NewStringRuleWithError(govalidator.IsDialString, ErrDialString)
 	// MAC validates if a string is a MAC address
@@ -217,24 +203,14 @@ var (
 	IPv6 = valid.NewStringRuleWithError(govalidator.IsIPv6, ErrIPv6)
 	// Subdomain validates if a string is valid subdomain
 	Subdomain = valid.NewStringRuleWithError(isSubdomain, ErrSubdomain)
-	// Domain validates if a string is valid domain
-	Domain = valid.NewStringRuleWithError(isDomain, ErrDomain)
-	// DNSName validates if a string is valid DNS name
-	DNSName = valid.NewStringRuleWithError(govalidator.IsDNSName, ErrDNSName)
 	// Host validates if a string is a valid IP (both v4 and v6) or a valid DNS name
 	Host = valid.NewStringRuleWithError(govalidator.IsHost, ErrHost)
 	// Port validates if a string is a valid port number
 	Port = valid.NewStringRuleWithError(govalidator.IsPort, ErrPort)
-	// MongoID validates if a string is a valid Mongo ID
-	MongoID = valid.NewStringRuleWithError(govalidator.IsMongoID, ErrMongoID)
 	// Latitude validates if a string is a valid latitude
 	Latitude = valid.NewStringRuleWithError(govalidator.IsLatitude, ErrLatitude)
 	// Longitude validates if a string is a valid longitude
 	Longitude = valid.NewStringRuleWithError(govalidator.IsLongitude, ErrLongitude)
-	// SSN validates if a string is a social security number (SSN)
-	SSN = valid.NewStringRuleWithError(govalidator.IsSSN, ErrSSN)
-	// Semver validates if a string is a valid semantic version
-	Semver = valid.NewStringRuleWithError(govalidator.IsSemver, ErrSemver)
 )
 
 var (
@@ -246,13 +222,9 @@ var (
 	// Domain regex source: https://stackoverflow.com/a/7933253
 	// Slightly modified: Removed 255 max length validation since Go regex does not
 	// support lookarounds. More info: https://stackoverflow.com/a/38935027
-	reDomain = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-z0-9])?\.)+(?:[a-zA-Z]{1,63}| xn--[a-z0-9]{1,59})$`)
+	reDomain = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-z0-9])?\.)+(?:[a-zA-Z]{1,63}|xn--[a-z0-9]{1,59})$`)
 )
 
-func isISBN(value string) bool {
-	return govalidator.IsISBN(value, 10) || govalidator.IsISBN(value, 13)
-}
-
 func isDigit(value string) bool {
 	return reDigit.MatchString(value)
 }
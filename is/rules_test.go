@@ -5,9 +5,12 @@
 package is
 
 import (
+	"encoding/json"
+	"net"
 	"strings"
 	"testing"
 
+	"github.com/maksliu/valid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,7 +49,7 @@ func TestAll(t *testing.T) {
 		{"Longitude", Longitude, "123.123", "abc", "must be a valid longitude"},
 		{"SSN", SSN, "100-00-1000", "100-0001000", "must be a valid social security number"},
 		{"Semver", Semver, "1.0.0", "1.0.0.0", "must be a valid semantic version"},
-		{"ISBN", ISBN, "1-61729-085-8", "1-61729-085-81", "must be a valid ISBN"},
+		{"ISBN", ISBN, "1-61729-085-8", "1-61729-O85-8", "must be a valid ISBN"},
 		{"ISBN10", ISBN10, "1-61729-085-8", "1-61729-085-81", "must be a valid ISBN-10"},
 		{"ISBN13", ISBN13, "978-4-87311-368-5", "978-4-87311-368-a", "must be a valid ISBN-13"},
 		{"UUID", UUID, "a987fbc9-4bed-3078-cf07-9141ba07c9f1", "a987fbc9-4bed-3078-cf07-9141ba07c9f3a", "must be a valid UUID"},
@@ -70,7 +73,6 @@ func TestAll(t *testing.T) {
 		{"HalfWidth", HalfWidth, "abc123い", "００１１", "must contain half-width characters"},
 		{"VariableWidth", VariableWidth, "３ー０123", "abc", "must contain both full-width and half-width characters"},
 		{"Hexadecimal", Hexadecimal, "FEF", "FTF", "must be a valid hexadecimal number"},
-		{"HexColor", HexColor, "F00", "FTF", "must be a valid hexadecimal color code"},
 		{"RGBColor", RGBColor, "rgb(100, 200, 1)", "abc", "must be a valid RGB color code"},
 		{"Int", Int, "100", "1.1", "must be an integer number"},
 		{"Float", Float, "1.1", "a.1", "must be a floating point number"},
@@ -91,6 +93,51 @@ func TestAll(t *testing.T) {
 	}
 }
 
+func TestByteSlice(t *testing.T) {
+	type text []byte
+	email := []byte("me@example.com")
+	assert.Nil(t, Email.Validate([]byte("me@example.com")), "t1")
+	assert.Nil(t, EmailFormat.Validate(&email), "t2")
+	assert.Nil(t, EmailFormat.Validate(text("me@example.com")), "t3")
+	assert.Nil(t, EmailFormat.Validate(json.RawMessage("me@example.com")), "t4")
+	assertError(t, "must be a valid email address", EmailFormat.Validate([]byte("me")), "t5")
+	assertError(t, "must be a valid email address", EmailFormat.Validate(text("me")), "t6")
+	assert.Nil(t, URL.Validate([]byte("http://example.com/path")), "t7")
+	assertError(t, "must be a valid URL", URL.Validate(text("not a url")), "t8")
+	assert.Nil(t, UUID.Validate(text("a987fbc9-4bed-3078-cf07-9141ba07c9f1")), "t9")
+	assert.Nil(t, EmailFormat.Validate([]byte{}), "t10")
+
+	s := struct {
+		Email text
+	}{text("me@example")}
+	err := valid.ValidateStruct(&s, valid.Field(&s.Email, valid.Required, EmailFormat))
+	assertError(t, "Email: must be a valid email address.", err, "t11")
+}
+
+func TestTextMarshaler(t *testing.T) {
+	assert.Nil(t, IPv4.Validate(net.ParseIP("10.0.0.1")))
+	assertError(t, "must be a valid IPv6 address", IPv6.Validate(net.ParseIP("10.0.0.1")), "t1")
+}
+
+type emailAddress string
+
+type contact struct {
+	user, domain string
+}
+
+func (c contact) String() string {
+	return c.user + "@" + c.domain
+}
+
+func TestStringer(t *testing.T) {
+	assert.Nil(t, EmailFormat.Validate(emailAddress("me@example.com")), "t1")
+	assertError(t, "must be a valid email address", EmailFormat.Validate(emailAddress("me")), "t2")
+	assert.Nil(t, EmailFormat.Validate(contact{"me", "example.com"}), "t3")
+	assert.Nil(t, EmailFormat.Validate(&contact{"me", "example.com"}), "t4")
+	assertError(t, "must be a valid email address", EmailFormat.Validate(contact{"me", "example"}), "t5")
+	assertError(t, "must be in lower case", LowerCase.Validate(contact{"Me", "example.com"}), "t6")
+}
+
 func assertError(t *testing.T, expected string, err error, tag string) {
 	if expected == "" {
 		assert.Nil(t, err, tag)
@@ -0,0 +1,220 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrSemverPrerelease is the error that returns in case of a prerelease version when prereleases are rejected.
+	ErrSemverPrerelease = valid.NewError("validation_is_semver_prerelease", "must not be a prerelease version")
+	// ErrSemverBuildMetadata is the error that returns in case of a version without build metadata when it is required.
+	ErrSemverBuildMetadata = valid.NewError("validation_is_semver_build_metadata", "must include build metadata")
+	// ErrSemverMinVersion is the error that returns in case of a version lower than the minimum one.
+	ErrSemverMinVersion = valid.NewError("validation_is_semver_min_version", "must be version {{.min}} or later")
+)
+
+// Semver validates if a string is a valid semantic version (e.g. "1.2.3-beta.1+build.5").
+// An optional leading "v" is accepted.
+// Call NoPrerelease(), RequireBuildMetadata() or MinVersion() to further restrict the accepted versions,
+// e.g. for release gates.
+var Semver = SemverRule{
+	err:           ErrSemver,
+	prereleaseErr: ErrSemverPrerelease,
+	buildErr:      ErrSemverBuildMetadata,
+	minErr:        ErrSemverMinVersion,
+}
+
+// SemverRule is a validation rule that checks if a string is a valid semantic version.
+type SemverRule struct {
+	noPrerelease  bool
+	requireBuild  bool
+	min           *semver
+	err           valid.Error
+	prereleaseErr valid.Error
+	buildErr      valid.Error
+	minErr        valid.Error
+}
+
+// NoPrerelease configures the rule to reject prerelease versions (e.g. "1.0.0-rc.1"), so only stable releases pass.
+func (r SemverRule) NoPrerelease() SemverRule {
+	r.noPrerelease = true
+	return r
+}
+
+// RequireBuildMetadata configures the rule to only accept versions with build metadata (e.g. "1.0.0+20230101").
+func (r SemverRule) RequireBuildMetadata() SemverRule {
+	r.requireBuild = true
+	return r
+}
+
+// MinVersion configures the rule to only accept versions with a precedence no lower than the given version.
+// Versions are compared following the semantic versioning specification, so "1.0.0-rc.1" is lower than "1.0.0",
+// and build metadata is ignored.
+// MinVersion panics if the given version is not a valid semantic version.
+func (r SemverRule) MinVersion(version string) SemverRule {
+	v, ok := parseSemver(version)
+	if !ok {
+		panic("is: invalid semantic version " + strconv.Quote(version))
+	}
+	r.min = &v
+	r.minErr = r.minErr.SetParams(map[string]interface{}{"min": version})
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r SemverRule) Error(message string) SemverRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SemverRule) ErrorObject(err valid.Error) SemverRule {
+	r.err = err
+	return r
+}
+
+// PrereleaseError sets the error message returned when a prerelease version is rejected.
+func (r SemverRule) PrereleaseError(message string) SemverRule {
+	r.prereleaseErr = r.prereleaseErr.SetMessage(message)
+	return r
+}
+
+// PrereleaseErrorObject sets the error struct returned when a prerelease version is rejected.
+func (r SemverRule) PrereleaseErrorObject(err valid.Error) SemverRule {
+	r.prereleaseErr = err
+	return r
+}
+
+// BuildMetadataError sets the error message returned when a version has no build metadata.
+func (r SemverRule) BuildMetadataError(message string) SemverRule {
+	r.buildErr = r.buildErr.SetMessage(message)
+	return r
+}
+
+// BuildMetadataErrorObject sets the error struct returned when a version has no build metadata.
+func (r SemverRule) BuildMetadataErrorObject(err valid.Error) SemverRule {
+	r.buildErr = err
+	return r
+}
+
+// MinVersionError sets the error message returned when a version is lower than the minimum one.
+func (r SemverRule) MinVersionError(message string) SemverRule {
+	r.minErr = r.minErr.SetMessage(message)
+	return r
+}
+
+// MinVersionErrorObject sets the error struct returned when a version is lower than the minimum one.
+func (r SemverRule) MinVersionErrorObject(err valid.Error) SemverRule {
+	r.minErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SemverRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	if !govalidator.IsSemver(str) {
+		return r.err
+	}
+	v, _ := parseSemver(str)
+	if r.noPrerelease && v.prerelease != nil {
+		return r.prereleaseErr
+	}
+	if r.requireBuild && !v.build {
+		return r.buildErr
+	}
+	if r.min != nil && v.compare(*r.min) < 0 {
+		return r.minErr
+	}
+	return nil
+}
+
+// semver holds the components of a semantic version that determine its precedence.
+type semver struct {
+	core       [3]uint64
+	prerelease []string
+	build      bool
+}
+
+// parseSemver parses a semantic version with an optional leading "v".
+func parseSemver(str string) (semver, bool) {
+	var v semver
+	if !govalidator.IsSemver(str) {
+		return v, false
+	}
+	str = strings.TrimPrefix(str, "v")
+	if i := strings.IndexByte(str, '+'); i >= 0 {
+		str, v.build = str[:i], true
+	}
+	if i := strings.IndexByte(str, '-'); i >= 0 {
+		str, v.prerelease = str[:i], strings.Split(str[i+1:], ".")
+	}
+	for i, part := range strings.Split(str, ".") {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return v, false
+		}
+		v.core[i] = n
+	}
+	return v, true
+}
+
+// compare returns -1, 0 or 1 if v has a lower, equal or higher precedence than other.
+func (v semver) compare(other semver) int {
+	for i := range v.core {
+		if v.core[i] != other.core[i] {
+			return compareUint(v.core[i], other.core[i])
+		}
+	}
+	switch {
+	case v.prerelease == nil && other.prerelease == nil:
+		return 0
+	case v.prerelease == nil:
+		return 1
+	case other.prerelease == nil:
+		return -1
+	}
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		if c := comparePrereleaseIdentifier(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.prerelease)), uint64(len(other.prerelease)))
+}
+
+// comparePrereleaseIdentifier compares two prerelease identifiers: numeric identifiers are compared numerically
+// and have a lower precedence than alphanumeric ones, which are compared lexically.
+func comparePrereleaseIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
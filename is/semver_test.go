@@ -0,0 +1,63 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemver(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  SemverRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Semver, "", ""},
+		{"t1.2", Semver, "1.0.0-rc.1+build.5", ""},
+		{"t1.3", Semver, "v1.0.0", ""},
+		{"t1.4", Semver, "1.0", "must be a valid semantic version"},
+		{"t1.5", Semver, 100, "must be either a string or byte slice"},
+		{"t2.1", Semver.NoPrerelease(), "", ""},
+		{"t2.2", Semver.NoPrerelease(), "1.2.3", ""},
+		{"t2.3", Semver.NoPrerelease(), "1.2.3+build", ""},
+		{"t2.4", Semver.NoPrerelease(), "1.2.3-beta", "must not be a prerelease version"},
+		{"t2.5", Semver.NoPrerelease(), "1.2", "must be a valid semantic version"},
+		{"t3.1", Semver.RequireBuildMetadata(), "", ""},
+		{"t3.2", Semver.RequireBuildMetadata(), "1.2.3+20230101", ""},
+		{"t3.3", Semver.RequireBuildMetadata(), "1.2.3-beta+exp.sha.5114f85", ""},
+		{"t3.4", Semver.RequireBuildMetadata(), "1.2.3", "must include build metadata"},
+		{"t4.1", Semver.MinVersion("1.0.0"), "", ""},
+		{"t4.2", Semver.MinVersion("1.0.0"), "1.0.0", ""},
+		{"t4.3", Semver.MinVersion("1.0.0"), "1.0.0+build", ""},
+		{"t4.4", Semver.MinVersion("1.0.0"), "v10.0.0", ""},
+		{"t4.5", Semver.MinVersion("1.0.0"), "0.9.12", "must be version 1.0.0 or later"},
+		{"t4.6", Semver.MinVersion("1.0.0"), "1.0.0-rc.1", "must be version 1.0.0 or later"},
+		{"t4.7", Semver.MinVersion("1.0.0-beta.2"), "1.0.0-beta.11", ""},
+		{"t4.8", Semver.MinVersion("1.0.0-beta.2"), "1.0.0-beta", "must be version 1.0.0-beta.2 or later"},
+		{"t4.9", Semver.MinVersion("1.0.0-alpha.beta"), "1.0.0-alpha.1", "must be version 1.0.0-alpha.beta or later"},
+		{"t4.10", Semver.MinVersion("1.0.0-alpha.1"), "1.0.0-alpha.beta", ""},
+		{"t5.1", Semver.NoPrerelease().MinVersion("2.0.0"), "2.0.0-rc.1", "must not be a prerelease version"},
+		{"t5.2", Semver.NoPrerelease().RequireBuildMetadata().MinVersion("2.0.0"), "2.1.0", "must include build metadata"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Semver.MinVersion("1.0.0").Validate("0.1.0")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_semver_min_version", err.(valid.Error).Code())
+		assert.Equal(t, "1.0.0", err.(valid.Error).Params()["min"])
+	}
+	assert.EqualError(t, Semver.NoPrerelease().PrereleaseError("stable only").Validate("1.0.0-rc"), "stable only")
+	assert.EqualError(t, Semver.RequireBuildMetadata().BuildMetadataError("no build").Validate("1.0.0"), "no build")
+	assert.EqualError(t, Semver.MinVersion("2.0.0").MinVersionError("too old").Validate("1.0.0"), "too old")
+	assert.Panics(t, func() { Semver.MinVersion("1.0") })
+}
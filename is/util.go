@@ -0,0 +1,23 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import "github.com/maksliu/valid"
+
+// stringValue returns the string held by the given value.
+// The boolean result is false if the value is nil or empty, in which case it should be considered valid.
+// An error is returned if the value is neither a string nor a byte slice.
+func stringValue(value interface{}) (string, bool, error) {
+	value, isNil := valid.Indirect(value)
+	if isNil || valid.IsEmpty(value) {
+		return "", false, nil
+	}
+
+	str, err := valid.EnsureString(value)
+	if err != nil {
+		return "", false, err
+	}
+	return str, true, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/maksliu/valid"
+)
+
+var (
+	// ErrUUIDNil is the error that returns in case of the nil UUID (all zeros) when it is rejected.
+	ErrUUIDNil = valid.NewError("validation_is_uuid_nil", "must not be the nil UUID")
+	// ErrUUIDVersion is the error that returns in case of a UUID of a different version than the required one.
+	ErrUUIDVersion = valid.NewError("validation_is_uuid_version", "must be a valid UUID v{{.version}}")
+)
+
+const (
+	nilUUID   = "00000000-0000-0000-0000-000000000000"
+	urnPrefix = "urn:uuid:"
+)
+
+// UUID validates if a string is a valid UUID of any version.
+// Call RejectNil() to reject the nil UUID (00000000-0000-0000-0000-000000000000)
+// and Version() to only accept UUIDs of a specific version.
+// Call AllowBraces() and AllowURN() to also accept the UUIDs wrapped in braces or prefixed with "urn:uuid:".
+var UUID = UUIDRule{err: ErrUUID, nilErr: ErrUUIDNil, versionErr: ErrUUIDVersion}
+
+// UUIDRule is a validation rule that checks if a string is a valid UUID.
+type UUIDRule struct {
+	rejectNil  bool
+	version    int
+	braces     bool
+	urn        bool
+	err        valid.Error
+	nilErr     valid.Error
+	versionErr valid.Error
+}
+
+// RejectNil configures the rule to reject the nil UUID, which is syntactically valid but meaningless as an ID.
+func (r UUIDRule) RejectNil() UUIDRule {
+	r.rejectNil = true
+	return r
+}
+
+// Version configures the rule to only accept UUIDs of the given version (e.g. 4).
+func (r UUIDRule) Version(version int) UUIDRule {
+	r.version = version
+	r.versionErr = r.versionErr.SetParams(map[string]interface{}{"version": version})
+	return r
+}
+
+// AllowBraces configures the rule to also accept a UUID wrapped in braces, as formatted by .NET and the Windows
+// registry, e.g. "{a987fbc9-4bed-3078-cf07-9141ba07c9f1}". The UUID inside the braces is validated as usual.
+func (r UUIDRule) AllowBraces() UUIDRule {
+	r.braces = true
+	return r
+}
+
+// AllowURN configures the rule to also accept a UUID in the URN form of RFC 4122,
+// e.g. "urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1". The "urn:uuid:" prefix is matched case-insensitively.
+func (r UUIDRule) AllowURN() UUIDRule {
+	r.urn = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r UUIDRule) Error(message string) UUIDRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r UUIDRule) ErrorObject(err valid.Error) UUIDRule {
+	r.err = err
+	return r
+}
+
+// NilError sets the error message returned when the nil UUID is rejected.
+func (r UUIDRule) NilError(message string) UUIDRule {
+	r.nilErr = r.nilErr.SetMessage(message)
+	return r
+}
+
+// NilErrorObject sets the error struct returned when the nil UUID is rejected.
+func (r UUIDRule) NilErrorObject(err valid.Error) UUIDRule {
+	r.nilErr = err
+	return r
+}
+
+// VersionError sets the error message returned when a UUID is not of the required version.
+func (r UUIDRule) VersionError(message string) UUIDRule {
+	r.versionErr = r.versionErr.SetMessage(message)
+	return r
+}
+
+// VersionErrorObject sets the error struct returned when a UUID is not of the required version.
+func (r UUIDRule) VersionErrorObject(err valid.Error) UUIDRule {
+	r.versionErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r UUIDRule) Validate(value interface{}) error {
+	str, ok, err := stringValue(value)
+	if !ok {
+		return err
+	}
+
+	str = r.unwrap(str)
+	if !govalidator.IsUUID(str) {
+		return r.err
+	}
+	if r.rejectNil && str == nilUUID {
+		return r.nilErr
+	}
+	if r.version != 0 && int(str[14]-'0') != r.version {
+		return r.versionErr
+	}
+	return nil
+}
+
+// unwrap returns the UUID inside the braces or after the URN prefix, if the rule accepts the form of the string.
+// Otherwise, the string is returned as is.
+func (r UUIDRule) unwrap(str string) string {
+	if r.braces && len(str) > 2 && str[0] == '{' && str[len(str)-1] == '}' {
+		return str[1 : len(str)-1]
+	}
+	if r.urn && len(str) > len(urnPrefix) && strings.EqualFold(str[:len(urnPrefix)], urnPrefix) {
+		return str[len(urnPrefix):]
+	}
+	return str
+}
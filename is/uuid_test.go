@@ -0,0 +1,73 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package is
+
+import (
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  UUIDRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", UUID, "", ""},
+		{"t1.2", UUID, "a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t1.3", UUID, "00000000-0000-0000-0000-000000000000", ""},
+		{"t1.4", UUID, "a987fbc9-4bed-3078-cf07-9141ba07c9f3a", "must be a valid UUID"},
+		{"t1.5", UUID, 123, "must be either a string or byte slice"},
+		{"t2.1", UUID.RejectNil(), "", ""},
+		{"t2.2", UUID.RejectNil(), "a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t2.3", UUID.RejectNil(), "00000000-0000-0000-0000-000000000000", "must not be the nil UUID"},
+		{"t2.4", UUID.RejectNil(), "xyz", "must be a valid UUID"},
+		{"t3.1", UUID.Version(4), "", ""},
+		{"t3.2", UUID.Version(4), "57b73598-8764-4ad0-a76a-679bb6640eb1", ""},
+		{"t3.3", UUID.Version(4), "a987fbc9-4bed-3078-cf07-9141ba07c9f1", "must be a valid UUID v4"},
+		{"t3.4", UUID.Version(4), "00000000-0000-0000-0000-000000000000", "must be a valid UUID v4"},
+		{"t3.5", UUID.Version(3), "a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t3.6", UUID.Version(7).RejectNil(), "00000000-0000-0000-0000-000000000000", "must not be the nil UUID"},
+		{"t3.7", UUID.Version(7), "018f3c3e-7b2a-7cde-8f00-0123456789ab", ""},
+		{"t4.1", UUID, "{a987fbc9-4bed-3078-cf07-9141ba07c9f1}", "must be a valid UUID"},
+		{"t4.2", UUID.AllowBraces(), "{a987fbc9-4bed-3078-cf07-9141ba07c9f1}", ""},
+		{"t4.3", UUID.AllowBraces(), "a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t4.4", UUID.AllowBraces(), "{a987fbc9-4bed-3078-cf07-9141ba07c9f1", "must be a valid UUID"},
+		{"t4.5", UUID.AllowBraces(), "{}", "must be a valid UUID"},
+		{"t4.6", UUID.AllowBraces(), "{xyz}", "must be a valid UUID"},
+		{"t4.7", UUID.AllowBraces().RejectNil(), "{00000000-0000-0000-0000-000000000000}", "must not be the nil UUID"},
+		{"t4.8", UUID.AllowBraces().Version(4), "{a987fbc9-4bed-3078-cf07-9141ba07c9f1}", "must be a valid UUID v4"},
+		{"t4.9", UUID.AllowBraces(), "urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1", "must be a valid UUID"},
+		{"t5.1", UUID, "urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1", "must be a valid UUID"},
+		{"t5.2", UUID.AllowURN(), "urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t5.3", UUID.AllowURN(), "URN:UUID:a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t5.4", UUID.AllowURN(), "a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t5.5", UUID.AllowURN(), "urn:uuid:", "must be a valid UUID"},
+		{"t5.6", UUID.AllowURN(), "urn:isbn:a987fbc9-4bed-3078-cf07-9141ba07c9f1", "must be a valid UUID"},
+		{"t5.7", UUID.AllowURN().RejectNil(), "urn:uuid:00000000-0000-0000-0000-000000000000", "must not be the nil UUID"},
+		{"t5.8", UUID.AllowURN().Version(3), "urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t5.9", UUID.AllowURN(), "{a987fbc9-4bed-3078-cf07-9141ba07c9f1}", "must be a valid UUID"},
+		{"t6.1", UUID.AllowBraces().AllowURN(), "", ""},
+		{"t6.2", UUID.AllowBraces().AllowURN(), "{a987fbc9-4bed-3078-cf07-9141ba07c9f1}", ""},
+		{"t6.3", UUID.AllowBraces().AllowURN(), "urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1", ""},
+		{"t6.4", UUID.AllowBraces().AllowURN(), "{urn:uuid:a987fbc9-4bed-3078-cf07-9141ba07c9f1}", "must be a valid UUID"},
+		{"t6.5", UUID.AllowBraces().AllowURN(), []byte("{a987fbc9-4bed-3078-cf07-9141ba07c9f1}"), ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := UUID.Version(4).Validate("a987fbc9-4bed-3078-cf07-9141ba07c9f1")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, 4, err.(valid.Error).Params()["version"])
+	}
+	assert.EqualError(t, UUID.RejectNil().NilError("no placeholder").Validate(nilUUID), "no placeholder")
+	assert.EqualError(t, UUID.Error("bad id").Validate("xyz"), "bad id")
+}
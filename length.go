@@ -5,6 +5,9 @@
 package valid
 
 import (
+	"encoding"
+	"reflect"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -19,11 +22,28 @@ var (
 	ErrLengthOutOfRange = NewError("validation_length_out_of_range", "the length must be between {{.min}} and {{.max}}")
 	// ErrLengthEmptyRequired is the error that returns in case of non-empty value.
 	ErrLengthEmptyRequired = NewError("validation_length_empty_required", "the value must be empty")
+	// ErrLengthTooLongExclusive is the error that returns in case of too long length with an exclusive upper bound.
+	ErrLengthTooLongExclusive = NewError("validation_length_too_long_exclusive", "the length must be less than {{.max}}")
+	// ErrLengthTooShortExclusive is the error that returns in case of too short length with an exclusive lower bound.
+	ErrLengthTooShortExclusive = NewError("validation_length_too_short_exclusive", "the length must be more than {{.min}}")
+	// ErrLengthOutOfRangeExclusive is the error that returns in case of out of range length with exclusive bounds.
+	ErrLengthOutOfRangeExclusive = NewError("validation_length_out_of_range_exclusive", "the length must be more than {{.min}} and less than {{.max}}")
+	// ErrLengthOutOfRangeExclusiveMin is the error that returns in case of out of range length with an exclusive
+	// lower bound and an inclusive upper bound.
+	ErrLengthOutOfRangeExclusiveMin = NewError("validation_length_out_of_range_exclusive_min", "the length must be more than {{.min}} and no more than {{.max}}")
+	// ErrLengthOutOfRangeExclusiveMax is the error that returns in case of out of range length with an inclusive
+	// lower bound and an exclusive upper bound.
+	ErrLengthOutOfRangeExclusiveMax = NewError("validation_length_out_of_range_exclusive_max", "the length must be no less than {{.min}} and less than {{.max}}")
 )
 
 // Length returns a validation rule that checks if a value's length is within the specified range.
 // If max is 0, it means there is no upper bound for the length.
-// This rule should only be used for validating strings, slices, maps, and arrays.
+// This rule should only be used for validating strings, slices, maps, arrays, and channels.
+// The length of a channel is the number of elements queued in its buffer, which is always 0 for an unbuffered one.
+// A value of a defined string type is measured like a string. Any other value that is not a string but has a string
+// form as returned by EnsureString (i.e. it implements fmt.Stringer or encoding.TextMarshaler) is measured by that form.
+// A slice, map, array or channel other than a byte slice is measured by its number of elements unless it implements
+// encoding.TextMarshaler (e.g. net.IP), so a collection with a String method for debugging is not affected.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func Length(min, max int) LengthRule {
 	return LengthRule{min: min, max: max, err: buildLengthRuleError(min, max)}
@@ -31,7 +51,7 @@ func Length(min, max int) LengthRule {
 
 // RuneLength returns a validation rule that checks if a string's rune length is within the specified range.
 // If max is 0, it means there is no upper bound for the length.
-// This rule should only be used for validating strings, slices, maps, and arrays.
+// This rule should only be used for validating strings, slices, maps, arrays, and channels.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 // If the value being validated is not a string, the rule works the same as Length.
 func RuneLength(min, max int) LengthRule {
@@ -41,12 +61,66 @@ func RuneLength(min, max int) LengthRule {
 	return r
 }
 
+// ExactLength returns a validation rule that checks if a value's length is exactly n.
+// It is equivalent to Length(n, n), and an empty value is considered valid as well.
+func ExactLength(n int) LengthRule {
+	return Length(n, n)
+}
+
+// ExactRuneLength returns a validation rule that checks if a string's rune length is exactly n.
+// It is equivalent to RuneLength(n, n), and an empty value is considered valid as well.
+func ExactRuneLength(n int) LengthRule {
+	return RuneLength(n, n)
+}
+
 // LengthRule is a validation rule that checks if a value's length is within the specified range.
 type LengthRule struct {
 	err Error
+	// customErr and message record the error and the message set by ErrorObject and Error, which are kept
+	// when err is rebuilt for new bounds.
+	customErr Error
+	message   string
+
+	min, max                   int
+	rune                       bool
+	count                      func(string) int
+	exclusiveMin, exclusiveMax bool
+	trim                       bool
+}
+
+// Trim configures the rule to measure the length of a string or byte slice with the leading and trailing white space
+// removed, e.g. "  ab  " has a length of 2. A value made of white space only is then considered empty and thus valid,
+// as Required.Trim() considers it empty. By default, white space is counted.
+func (r LengthRule) Trim() LengthRule {
+	r.trim = true
+	return r
+}
 
-	min, max int
-	rune     bool
+// CountWith configures the rule to measure the length of a string with the given function instead of counting bytes
+// (or runes for RuneLength), e.g. to count the grapheme clusters (user-perceived characters) as is.GraphemeLength does.
+// A byte slice (including one of a defined type) and the text form of a value are counted as strings as well.
+// Any other value (e.g. a slice of strings) is still measured by Length.
+func (r LengthRule) CountWith(count func(string) int) LengthRule {
+	r.count = count
+	return r
+}
+
+// ExclusiveMin configures the rule to require the length to be strictly greater than min, e.g. Length(5, 10).ExclusiveMin()
+// accepts lengths from 6 to 10. With a min of 0, the rule requires a length greater than 0, although an empty value is
+// still considered valid. Unless an error is set by ErrorObject, the error of the rule describes the new bounds.
+func (r LengthRule) ExclusiveMin() LengthRule {
+	r.exclusiveMin = true
+	r.err = r.buildError()
+	return r
+}
+
+// ExclusiveMax configures the rule to require the length to be strictly less than max, e.g. Length(5, 10).ExclusiveMax()
+// accepts lengths from 5 to 9. It has no effect if max is 0, which means there is no upper bound.
+// Unless an error is set by ErrorObject, the error of the rule describes the new bounds.
+func (r LengthRule) ExclusiveMax() LengthRule {
+	r.exclusiveMax = true
+	r.err = r.buildError()
+	return r
 }
 
 // Validate checks if the given value is valid or not.
@@ -60,31 +134,148 @@ func (r LengthRule) Validate(value interface{}) error {
 		l   int
 		err error
 	)
-	if s, ok := value.(string); ok && r.rune {
+	if _, ok := value.(string); !ok && measuredAsText(value) {
+		// a value that is not natively a string is measured by its text form, if any
+		var text string
+		if text, ok, err = stringOf(value); ok {
+			if err != nil {
+				return err
+			}
+			value = text
+		}
+	}
+	if r.trim {
+		if value = trimSpace(value); IsEmpty(value) {
+			return nil
+		}
+	}
+	if isString, s, isBytes, bs := StringOrBytes(value); r.count != nil && (isString || isBytes) {
+		if isBytes {
+			s = string(bs)
+		}
+		l = r.count(s)
+	} else if s, ok := value.(string); ok && r.rune {
 		l = utf8.RuneCountInString(s)
 	} else if l, err = LengthOfValue(value); err != nil {
 		return err
 	}
 
-	if r.min > 0 && l < r.min || r.max > 0 && l > r.max || r.min == 0 && r.max == 0 && l > 0 {
-		return r.err
+	return r.checkLength(l)
+}
+
+// measuredAsText reports whether a value that is not a string is measured by its string form, if any.
+// A slice, map, array or channel other than a byte slice is measured by its elements unless it implements
+// encoding.TextMarshaler, so that a collection with a String method for debugging is not affected.
+func measuredAsText(value interface{}) bool {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		if !isByteSlice(v) {
+			_, ok := value.(encoding.TextMarshaler)
+			return ok
+		}
 	}
+	return true
+}
 
+// validateString checks a string without reflection. It returns the same result as Validate.
+func (r LengthRule) validateString(value string) error {
+	if r.trim {
+		value = strings.TrimSpace(value)
+	}
+	if value == "" {
+		return nil
+	}
+	if r.count != nil {
+		return r.checkLength(r.count(value))
+	}
+	if r.rune {
+		return r.checkLength(utf8.RuneCountInString(value))
+	}
+	return r.checkLength(len(value))
+}
+
+// checkLength returns the error of the rule if the given length is out of the range.
+func (r LengthRule) checkLength(l int) error {
+	if r.tooShort(l) || r.tooLong(l) || r.min == 0 && r.max == 0 && !r.exclusiveMin && l > 0 {
+		return r.err
+	}
 	return nil
 }
 
 // Error sets the error message for the rule.
 func (r LengthRule) Error(message string) LengthRule {
-	r.err = r.err.SetMessage(message)
+	if r.customErr != nil {
+		r.customErr = r.customErr.SetMessage(message)
+	} else {
+		r.message = message
+	}
+	r.err = r.buildError()
 	return r
 }
 
 // ErrorObject sets the error struct for the rule.
 func (r LengthRule) ErrorObject(err Error) LengthRule {
-	r.err = err
+	r.customErr, r.message = err, ""
+	r.err = r.buildError()
 	return r
 }
 
+// tooShort reports whether the length is below the lower bound.
+func (r LengthRule) tooShort(l int) bool {
+	if r.exclusiveMin {
+		return l <= r.min
+	}
+	return r.min > 0 && l < r.min
+}
+
+// tooLong reports whether the length is above the upper bound.
+func (r LengthRule) tooLong(l int) bool {
+	if r.max == 0 {
+		return false
+	}
+	if r.exclusiveMax {
+		return l >= r.max
+	}
+	return l > r.max
+}
+
+// buildError returns the error set by ErrorObject, or the error describing the bounds of the rule, taking into account
+// whether they are exclusive, with the message set by Error.
+func (r LengthRule) buildError() Error {
+	if r.customErr != nil {
+		return r.customErr
+	}
+	err := r.boundsError()
+	if r.message != "" {
+		err = err.SetMessage(r.message)
+	}
+	return err
+}
+
+// boundsError returns the default error describing the bounds of the rule.
+func (r LengthRule) boundsError() Error {
+	exclusiveMax := r.exclusiveMax && r.max > 0
+	if !r.exclusiveMin && !exclusiveMax {
+		return buildLengthRuleError(r.min, r.max)
+	}
+
+	var err Error
+	switch {
+	case r.max == 0:
+		err = ErrLengthTooShortExclusive
+	case r.exclusiveMin && exclusiveMax:
+		err = ErrLengthOutOfRangeExclusive
+	case r.exclusiveMin:
+		err = ErrLengthOutOfRangeExclusiveMin
+	case r.min == 0:
+		err = ErrLengthTooLongExclusive
+	default:
+		err = ErrLengthOutOfRangeExclusiveMax
+	}
+	return err.SetParams(map[string]interface{}{"min": r.min, "max": r.max})
+}
+
 func buildLengthRuleError(min, max int) (err Error) {
 	if min == 0 && max > 0 {
 		err = ErrLengthTooLong
@@ -6,7 +6,12 @@ package valid
 
 import (
 	"database/sql"
+	"errors"
+	"net"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -35,6 +40,14 @@ func TestLength(t *testing.T) {
 		{"t14", 2, 2, "ab", ""},
 		{"t15", 0, 0, "", ""},
 		{"t16", 0, 0, "ab", "the value must be empty"},
+		{"t17", 7, 8, net.ParseIP("10.0.0.1"), ""},
+		{"t18", 0, 7, net.ParseIP("10.0.0.1"), "the length must be no more than 7"},
+		// values with a string form
+		{"t19", 6, 6, statusActive, ""},
+		{"t20", 1, 5, statusActive, "the length must be between 1 and 5"},
+		{"t21", 6, 6, hexBytes("abc"), ""},
+		{"t22", 3, 3, tagList{"a", "b", "c"}, ""},
+		{"t23", 3, 3, stringText{"abc"}, "the length must be exactly 3"},
 	}
 
 	for _, test := range tests {
@@ -44,6 +57,27 @@ func TestLength(t *testing.T) {
 	}
 }
 
+// tagList is a collection with a String method, which is measured by its elements.
+type tagList []string
+
+func (l tagList) String() string {
+	return strings.Join(l, ",")
+}
+
+func TestLength_Channel(t *testing.T) {
+	ch := make(chan int, 5)
+	// nil and unbuffered channels have a length of 0
+	assertError(t, "the length must be between 1 and 2", Length(1, 2).Validate(make(chan int)), "t1")
+	assert.Nil(t, Length(1, 2).Validate((chan int)(nil)))
+	assertError(t, "the length must be between 1 and 2", Length(1, 2).Validate(ch), "t2")
+	ch <- 1
+	assert.Nil(t, Length(1, 2).Validate(ch))
+	ch <- 2
+	ch <- 3
+	assertError(t, "the length must be between 1 and 2", Length(1, 2).Validate(ch), "t3")
+	assert.Nil(t, Length(0, 5).Validate(&ch))
+}
+
 func TestRuneLength(t *testing.T) {
 	var v *string
 	tests := []struct {
@@ -70,6 +104,10 @@ func TestRuneLength(t *testing.T) {
 		{"t12", 2, 4, &sql.NullString{String: "abc", Valid: true}, ""},
 		{"t13", 2, 3, &sql.NullString{String: "💥💥", Valid: true}, ""},
 		{"t14", 2, 3, &sql.NullString{String: "💥", Valid: true}, "the length must be between 2 and 3"},
+		{"t15", 2, 3, textValue{text: "💥💥"}, ""},
+		{"t16", 2, 3, textValue{err: errors.New("abc")}, "abc"},
+		{"t17", 2, 3, MyString("💥💥"), ""},
+		{"t18", 2, 3, MyString("💥"), "the length must be between 2 and 3"},
 	}
 
 	for _, test := range tests {
@@ -79,6 +117,144 @@ func TestRuneLength(t *testing.T) {
 	}
 }
 
+func TestLength_Time(t *testing.T) {
+	// a time.Time is measured by its String(), not by its RFC 3339 text
+	tm := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	assert.Nil(t, Length(29, 29).Validate(tm))
+	assertError(t, "the length must be no more than 20", Length(0, 20).Validate(tm), "t1")
+	assert.Nil(t, Length(0, 20).Validate(tm.Format(time.RFC3339)))
+	assert.Nil(t, Match(regexp.MustCompile(`^\d{4}-\d{2}-\d{2} `)).Validate(tm))
+}
+
+func TestLengthRule_CountWith(t *testing.T) {
+	// counts the words separated by spaces
+	words := func(s string) int { return len(strings.Fields(s)) }
+	tests := []struct {
+		tag   string
+		rule  LengthRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Length(2, 3).CountWith(words), "", ""},
+		{"t2", Length(2, 3).CountWith(words), "a long word", ""},
+		{"t3", Length(2, 3).CountWith(words), "word", "the length must be between 2 and 3"},
+		{"t4", Length(2, 3).CountWith(words), "a b c d", "the length must be between 2 and 3"},
+		{"t5", Length(2, 3).CountWith(words), " ", "the length must be between 2 and 3"},
+		{"t6", Length(2, 3).CountWith(words).Trim(), " ", ""},
+		{"t7", RuneLength(2, 3).CountWith(words), "💥💥 💥", ""},
+		{"t8", Length(2, 3).CountWith(words), []byte("ab"), "the length must be between 2 and 3"},
+		{"t9", Length(2, 3).CountWith(words), textValue{text: "two words"}, ""},
+		{"t10", Length(2, 3).CountWith(words).ExclusiveMin(), "two words", "the length must be more than 2 and no more than 3"},
+		{"t11", Length(2, 3).CountWith(words), []byte("two words"), ""},
+		{"t12", Length(2, 3).CountWith(words).Trim(), MyBytes(" a b "), ""},
+		{"t13", Length(2, 3).CountWith(words), []string{"a", "b c d"}, ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+		if str, ok := test.value.(string); ok {
+			assertError(t, test.err, ValidateString(str, test.rule), test.tag)
+		}
+	}
+}
+
+func TestLengthRule_Exclusive(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  LengthRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Length(2, 4).ExclusiveMin(), "abc", ""},
+		{"t1.2", Length(2, 4).ExclusiveMin(), "abcd", ""},
+		{"t1.3", Length(2, 4).ExclusiveMin(), "ab", "the length must be more than 2 and no more than 4"},
+		{"t1.4", Length(2, 4).ExclusiveMin(), "abcde", "the length must be more than 2 and no more than 4"},
+		{"t2.1", Length(2, 4).ExclusiveMax(), "ab", ""},
+		{"t2.2", Length(2, 4).ExclusiveMax(), "abcd", "the length must be no less than 2 and less than 4"},
+		{"t2.3", Length(2, 4).ExclusiveMax(), "a", "the length must be no less than 2 and less than 4"},
+		{"t3.1", Length(2, 4).ExclusiveMin().ExclusiveMax(), "abc", ""},
+		{"t3.2", Length(2, 4).ExclusiveMax().ExclusiveMin(), "ab", "the length must be more than 2 and less than 4"},
+		{"t3.3", Length(2, 4).ExclusiveMin().ExclusiveMax(), "abcd", "the length must be more than 2 and less than 4"},
+		{"t4.1", Length(5, 0).ExclusiveMin(), "abcdef", ""},
+		{"t4.2", Length(5, 0).ExclusiveMin(), "abcde", "the length must be more than 5"},
+		{"t4.3", Length(5, 0).ExclusiveMax(), "abcde", ""},
+		{"t4.4", Length(5, 0).ExclusiveMax(), "abcd", "the length must be no less than 5"},
+		{"t5.1", Length(0, 5).ExclusiveMax(), "abcd", ""},
+		{"t5.2", Length(0, 5).ExclusiveMax(), "abcde", "the length must be less than 5"},
+		{"t5.3", Length(0, 5).ExclusiveMin(), "a", ""},
+		{"t5.4", Length(0, 5).ExclusiveMin(), "abcdef", "the length must be more than 0 and no more than 5"},
+		{"t6.1", Length(0, 0).ExclusiveMin(), "a", ""},
+		{"t6.2", Length(0, 0).ExclusiveMin(), "", ""},
+		{"t6.3", Length(0, 0).ExclusiveMax(), "a", "the value must be empty"},
+		{"t7.1", RuneLength(1, 2).ExclusiveMin(), "中文", ""},
+		{"t7.2", RuneLength(1, 2).ExclusiveMin(), "中", "the length must be more than 1 and no more than 2"},
+		{"t7.3", Length(1, 2).ExclusiveMin(), []int{1}, "the length must be more than 1 and no more than 2"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Length(2, 4).ExclusiveMin().Error("too short").Validate("ab")
+	assert.EqualError(t, err, "too short")
+	assert.Equal(t, "validation_length_out_of_range_exclusive_min", Length(2, 4).ExclusiveMin().err.Code())
+
+	// an error set before ExclusiveMin or ExclusiveMax is kept
+	r := Length(2, 4).Error("too short").ExclusiveMin()
+	assert.EqualError(t, r.Validate("ab"), "too short")
+	assert.Equal(t, "validation_length_out_of_range_exclusive_min", r.err.Code())
+	custom := NewError("code", "abc")
+	assert.Equal(t, custom, Length(2, 4).ErrorObject(custom).ExclusiveMax().Validate("abcd"))
+	assert.EqualError(t, Length(2, 4).ErrorObject(custom).Error("def").ExclusiveMin().Validate("ab"), "def")
+	assert.EqualError(t, Length(2, 4).Error("def").ErrorObject(custom).ExclusiveMin().Validate("ab"), "abc")
+}
+
+func TestLengthRule_Trim(t *testing.T) {
+	blank := "   "
+	tests := []struct {
+		tag   string
+		rule  LengthRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Length(1, 2), blank, "the length must be between 1 and 2"},
+		{"t1.2", Length(1, 2).Trim(), blank, ""},
+		{"t1.3", Length(1, 2).Trim(), &blank, ""},
+		{"t1.4", Length(4, 0), "  ab  ", ""},
+		{"t1.5", Length(4, 0).Trim(), "  ab  ", "the length must be no less than 4"},
+		{"t1.6", Length(1, 2).Trim(), " ab ", ""},
+		{"t1.7", Length(1, 2).Trim(), []byte(" abc "), "the length must be between 1 and 2"},
+		{"t1.8", Length(1, 2).Trim(), MyString(" ab "), ""},
+		{"t1.9", Length(0, 0).Trim(), blank, ""},
+		{"t1.10", Length(1, 2).Trim(), []int{1, 2, 3}, "the length must be between 1 and 2"},
+		{"t2.1", RuneLength(2, 2).Trim(), " 中文 ", ""},
+		{"t2.2", RuneLength(2, 2), " 中文 ", "the length must be exactly 2"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// Required and Length handle white space consistently when both are trimmed
+	err := Validate(blank, Required.Trim(), Length(1, 10).Trim())
+	assertError(t, "cannot be blank", err, "t3")
+	assert.Nil(t, Validate(" abc ", Required.Trim(), Length(3, 3).Trim()))
+}
+
+func TestExactLength(t *testing.T) {
+	assert.Nil(t, ExactLength(8).Validate("abcdefgh"))
+	assert.Nil(t, ExactLength(8).Validate(""))
+	assertError(t, "the length must be exactly 8", ExactLength(8).Validate("abcdefg"), "t1")
+	assertError(t, "the length must be exactly 8", ExactLength(8).Validate("abcdefghi"), "t2")
+	assertError(t, "the length must be exactly 2", ExactLength(2).Validate("中文"), "t3")
+
+	assert.Nil(t, ExactRuneLength(2).Validate("中文"))
+	assertError(t, "the length must be exactly 2", ExactRuneLength(2).Validate("中文字"), "t4")
+}
+
 func Test_LengthRule_Error(t *testing.T) {
 	r := Length(10, 20)
 	assert.Equal(t, "the length must be between 10 and 20", r.Validate("abc").Error())
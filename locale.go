@@ -0,0 +1,228 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var (
+	// catalog holds the messages of the errors indexed by locale and key (see lookupMessage).
+	catalog   = map[string]map[string]string{}
+	catalogMu sync.RWMutex
+
+	// sourceMessages holds the English messages registered first for each key, i.e. the shipped messages,
+	// which tell the errors whose messages are customized from the others.
+	sourceMessages = map[string]string{}
+
+	// defaultLocale is the locale set by SetLocale.
+	defaultLocale atomic.Value
+)
+
+type localeKey struct{}
+
+func init() {
+	if err := RegisterMessagesFS(locales, "locales"); err != nil {
+		panic(err)
+	}
+}
+
+// SetLocale sets the locale (e.g. "fr") in which the errors of the rules are reported by Validate, ValidateStruct
+// and the other validation functions, unless the context of a validation specifies another one with WithLocale.
+// The messages of English, Spanish (es), French (fr) and German (de) are shipped, and a locale with a region
+// (e.g. "fr-CA") falls back to its language. An error whose message is not found in the locale keeps its English
+// message, as does an error whose message is customized (e.g. by the Error or ErrorObject method of a rule, see
+// RegisterMessages). An empty locale,
+// which is the default, disables the translation. SetLocale should be called during the application initialization.
+func SetLocale(locale string) {
+	defaultLocale.Store(normalizeLocale(locale))
+}
+
+// WithLocale returns a copy of the context that makes ValidateWithContext report the errors in the given locale
+// (e.g. the language of the user sending a request), overriding the one set by SetLocale. Please refer to
+// SetLocale for how the messages are looked up.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, normalizeLocale(locale))
+}
+
+// RegisterMessages adds the messages of the given locale to the message catalog, replacing the existing messages
+// of the same keys. This allows translating the errors of custom rules, shipping a new locale, or rewording the
+// shipped messages. The messages are indexed by the error codes, and may use the same parameters as the English
+// messages, e.g.
+//
+//	valid.RegisterMessages("it", map[string]string{
+//	    "validation_required":        "non può essere vuoto",
+//	    "validation_length_too_long": "la lunghezza deve essere al massimo {{.max}}",
+//	})
+//
+// A code shared by several messages (e.g. validation_required, whose English messages are "cannot be blank",
+// "is required" and "cannot be empty") can be translated per message with a key made of the code and the English
+// message separated by a colon, e.g. "validation_required:is required".
+//
+// The English (en) messages registered first for a code are taken as the messages of its errors, so that an error
+// created with the code but another message, e.g. NewError("validation_required", "Name is mandatory"), is considered
+// customized and is not translated. The messages of the built-in rules are registered when the packages are
+// initialized, so registering English messages afterwards only rewords them. The errors of a code without English
+// messages are always translated.
+func RegisterMessages(locale string, messages map[string]string) {
+	locale = normalizeLocale(locale)
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	m := catalog[locale]
+	if m == nil {
+		m = map[string]string{}
+		catalog[locale] = m
+	}
+	for key, message := range messages {
+		m[key] = message
+		if _, ok := sourceMessages[key]; !ok && locale == "en" {
+			sourceMessages[key] = message
+		}
+	}
+}
+
+// RegisterMessagesFS registers the messages stored in the JSON files of the given directory of a file system
+// (e.g. an embed.FS). Each file holds an object of the messages of a locale, which is given by the file name,
+// e.g. "it.json" for Italian. Please refer to RegisterMessages for the keys of the messages.
+func RegisterMessagesFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || path.Ext(name) != ".json" {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return err
+		}
+		RegisterMessages(strings.TrimSuffix(name, ".json"), messages)
+	}
+	return nil
+}
+
+// Localize returns a copy of the validation error with its messages in the given locale, e.g. to report an error
+// to users of different languages. Nested errors are localized recursively. Please refer to SetLocale for how the
+// messages are looked up. An empty (or "en") locale restores the English messages.
+func Localize(err error, locale string) error {
+	return localizeError(err, normalizeLocale(locale))
+}
+
+// localize translates the error returned by a rule into the locale of the context, or the one set by SetLocale.
+// If ctx is nil, only the latter is used.
+func localize(ctx context.Context, err error) error {
+	locale, _ := defaultLocale.Load().(string)
+	if ctx != nil {
+		if l, ok := ctx.Value(localeKey{}).(string); ok {
+			locale = l
+		}
+	}
+	if locale == "" {
+		return err
+	}
+	return localizeError(err, locale)
+}
+
+func localizeError(err error, locale string) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case ErrorObject:
+		if e.original != "" {
+			e.message = lookupMessage(locale, e.code, e.original)
+		}
+		return e
+	case Errors:
+		errs := Errors{}
+		for key, value := range e {
+			errs[key] = localizeError(value, locale)
+		}
+		return errs
+	case OrderedErrors:
+		errs := OrderedErrors{Errors: Errors{}}
+		for _, key := range e.keys {
+			errs.add(key, localizeError(e.Errors[key], locale))
+		}
+		return errs
+	case KeyedErrors:
+		return e.withErrors(localizeError(e.Errors, locale))
+	case FieldErrors:
+		errs := make([]error, len(e))
+		for i, fe := range e {
+			errs[i] = localizeError(fe.Err, locale)
+		}
+		return e.withErrors(errs)
+	case FieldError:
+		err := localizeError(e.Err, locale)
+		return FieldError{Field: e.Field, Message: err.Error(), Err: err}
+	case RuleError:
+		e.err = localizeError(e.err, locale)
+		return e
+	case orError:
+		errs := make([]error, len(e.errs))
+		for i, err := range e.errs {
+			errs[i] = localizeError(err, locale)
+		}
+		return newOrError(localizeError(e.err, locale).(Error), errs)
+	case warningError:
+		return warningError{localizeError(e.error, locale)}
+	case abortError:
+		return abortError{localizeError(e.error, locale)}
+	}
+	return err
+}
+
+// lookupMessage returns the message of an error in the given locale, first by the code and the English message,
+// and then by the code alone. If the locale has a region (e.g. "fr-ca"), its language is also looked up.
+// The English message is returned if no message is found, or if it is not the registered English message of the code.
+func lookupMessage(locale, code, message string) string {
+	if code == "" {
+		return message
+	}
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	if _, ok := sourceMessages[code+":"+message]; !ok {
+		if source, ok := sourceMessages[code]; ok && source != message {
+			return message
+		}
+	}
+	for locale != "" {
+		if m := catalog[locale]; m != nil {
+			if msg, ok := m[code+":"+message]; ok {
+				return msg
+			}
+			if msg, ok := m[code]; ok {
+				return msg
+			}
+		}
+		i := strings.LastIndexByte(locale, '-')
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return message
+}
+
+// normalizeLocale converts a locale to lower case with hyphens as separators, e.g. "fr_CA" to "fr-ca".
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
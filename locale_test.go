@@ -0,0 +1,211 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetLocale(t *testing.T) {
+	defer SetLocale("")
+
+	tests := []struct {
+		tag      string
+		locale   string
+		value    interface{}
+		rules    []Rule
+		expected string
+	}{
+		{"t1", "", "", []Rule{Required}, "cannot be blank"},
+		{"t2", "fr", "", []Rule{Required}, "ne peut pas être vide"},
+		{"t3", "es", "abcd", []Rule{Length(1, 3)}, "la longitud debe estar entre 1 y 3"},
+		{"t4", "de", 1, []Rule{Min(2)}, "darf nicht kleiner als 2 sein"},
+		{"t5", "fr_CA", "", []Rule{Required}, "ne peut pas être vide"},
+		{"t6", "fr", 0, []Rule{Required}, "est obligatoire"},
+		{"t7", "fr", []int{}, []Rule{Required}, "ne peut pas être vide"},
+		{"t8", "it", "", []Rule{Required}, "cannot be blank"},
+		{"t9", "fr", "", []Rule{Required.Error("is missing")}, "is missing"},
+		{"t10", "fr", "abc", []Rule{By(func(interface{}) error { return NewError("", "xyz") })}, "xyz"},
+		{"t11", "fr", "abc", []Rule{By(func(interface{}) error { return NewError("custom_code", "xyz") })}, "xyz"},
+		{"t12", "fr", "", []Rule{Required.ErrorObject(NewError("validation_required", "Name is mandatory"))}, "Name is mandatory"},
+		{"t13", "fr", 0, []Rule{Required.ErrorObject(NewError("validation_required", "is required"))}, "est obligatoire"},
+		{"t14", "fr", "", []Rule{Required.ErrorObject(ErrRequired)}, "ne peut pas être vide"},
+		{"t15", "de", "c", []Rule{Or(In("a"), Length(3, 3))}, "muss eine der Bedingungen erfüllen: muss ein gültiger Wert sein; die Länge muss genau 3 betragen"},
+	}
+
+	for _, test := range tests {
+		SetLocale(test.locale)
+		assertError(t, test.expected, Validate(test.value, test.rules...), test.tag)
+		assertError(t, test.expected, ValidateWithContext(context.Background(), test.value, test.rules...), test.tag)
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	defer SetLocale("")
+	SetLocale("de")
+
+	ctx := WithLocale(context.Background(), "es")
+	assertError(t, "no puede estar vacío", ValidateWithContext(ctx, "", Required), "t1")
+	assertError(t, "darf nicht leer sein", Validate("", Required), "t2")
+
+	// an empty locale in the context disables the translation
+	ctx = WithLocale(context.Background(), "")
+	assertError(t, "cannot be blank", ValidateWithContext(ctx, "", Required), "t3")
+
+	// the errors of a map and of a rule wrapped into a RuleError are localized
+	ctx = WithLocale(context.Background(), "fr")
+	err := ValidateWithContext(ctx, map[string]interface{}{"name": "", "age": 1}, Map(
+		Key("name", Required),
+		Key("age", Min(18)),
+	))
+	assertError(t, "age: doit être supérieur ou égal à 18; name: ne peut pas être vide.", err, "t4")
+	err = ValidateWithContext(WithRuleErrors(ctx, false), 1, Min(2))
+	if re, ok := err.(RuleError); assert.True(t, ok, "t5") {
+		assert.Equal(t, "doit être supérieur ou égal à 2", re.Error(), "t5")
+		assert.Equal(t, "validation_min_greater_equal_than_required", re.Code(), "t5")
+	}
+}
+
+func TestLocale_Struct(t *testing.T) {
+	defer SetLocale("")
+	SetLocale("es")
+
+	s := struct {
+		Name  string
+		Email string
+	}{Email: "abc"}
+	err := ValidateStruct(&s,
+		Field(&s.Name, Required),
+		Field(&s.Email, Length(5, 0)),
+	)
+	assertError(t, "Email: la longitud debe ser al menos 5; Name: no puede estar vacío.", err, "t1")
+}
+
+func TestLocalize(t *testing.T) {
+	err := Validate(map[string]interface{}{"name": ""}, Map(Key("name", Required)))
+	assertError(t, "name: cannot be blank.", err, "t1")
+
+	fr := Localize(err, "fr")
+	assertError(t, "name: ne peut pas être vide.", fr, "t2")
+	assertError(t, "name: cannot be blank.", err, "t3")
+	assertError(t, "name: cannot be blank.", Localize(fr, "en"), "t4")
+	assertError(t, "name: cannot be blank.", Localize(fr, ""), "t5")
+	assertError(t, "no puede estar vacío", Localize(Localize(ErrRequired, "fr"), "es"), "t6")
+
+	assert.Nil(t, Localize(nil, "fr"), "t7")
+	assertError(t, "xyz", Localize(NewError("", "xyz"), "fr"), "t8")
+
+	// the errors joined by Or are localized with it
+	err = Validate("c", Or(In("a", "b"), Length(3, 3)))
+	fr = Localize(err, "fr")
+	assertError(t, "doit satisfaire l'une des conditions : doit être une valeur valide; la longueur doit être exactement de 3", fr, "t10")
+	assert.Equal(t, "validation_or", fr.(Error).Code(), "t10")
+	assert.Equal(t, "doit être une valeur valide; la longueur doit être exactement de 3", fr.(Error).Params()["errors"], "t10")
+	assertError(t, err.Error(), Localize(fr, "en"), "t11")
+
+	e := Localize(ErrLengthOutOfRange.SetParams(map[string]interface{}{"min": 1, "max": 3}), "de").(Error)
+	assert.Equal(t, "validation_length_out_of_range", e.Code(), "t9")
+	assert.Equal(t, "die Länge muss zwischen 1 und 3 liegen", e.Error(), "t9")
+	assert.Equal(t, "die Länge muss zwischen {{.min}} und {{.max}} liegen", e.Message(), "t9")
+}
+
+func TestRegisterMessages(t *testing.T) {
+	defer SetLocale("")
+
+	RegisterMessages("x-test", map[string]string{
+		"custom_code":         "custom {{.value}}",
+		"validation_required": "blank",
+	})
+	// the region falls back to the locale registered above
+	SetLocale("x-test-region")
+
+	err := Validate("abc", By(func(interface{}) error {
+		return NewError("custom_code", "custom").SetParams(map[string]interface{}{"value": 1})
+	}))
+	assertError(t, "custom 1", err, "t1")
+	assertError(t, "blank", Validate("", Required), "t2")
+
+	RegisterMessages("X_Test", map[string]string{"validation_required": "empty"})
+	assertError(t, "empty", Validate("", Required), "t3")
+
+	// registering English messages rewords the shipped ones without making their errors customized
+	RegisterMessages("en", map[string]string{"validation_length_too_long": "is too long"})
+	defer RegisterMessages("en", map[string]string{"validation_length_too_long": "the length must be no more than {{.max}}"})
+	SetLocale("en")
+	assertError(t, "is too long", Validate("abc", Length(0, 2)), "t4")
+	SetLocale("fr")
+	assertError(t, "la longueur doit être d'au plus 2", Validate("abc", Length(0, 2)), "t5")
+}
+
+func TestRegisterMessagesFS(t *testing.T) {
+	defer SetLocale("")
+
+	fsys := fstest.MapFS{
+		"messages/x-fs.json":  {Data: []byte(`{"validation_required": "vide"}`)},
+		"messages/readme.txt": {Data: []byte(`not a catalog`)},
+		"bad/x-fs.json":       {Data: []byte(`{"validation_required": 1}`)},
+	}
+	assert.NoError(t, RegisterMessagesFS(fsys, "messages"))
+	SetLocale("x-fs")
+	assertError(t, "vide", Validate("", Required), "t1")
+
+	assert.Error(t, RegisterMessagesFS(fsys, "bad"))
+	assert.Error(t, RegisterMessagesFS(fsys, "missing"))
+}
+
+func TestLocales(t *testing.T) {
+	// the catalogs of the is package are checked here as well, so that both are checked the same way
+	for _, dir := range []string{"locales", "is/locales"} {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err, dir)
+		catalogs := map[string]map[string]string{}
+		for _, entry := range entries {
+			data, err := os.ReadFile(path.Join(dir, entry.Name()))
+			require.NoError(t, err, entry.Name())
+			var messages map[string]string
+			require.NoError(t, json.Unmarshal(data, &messages), entry.Name())
+			catalogs[strings.TrimSuffix(entry.Name(), ".json")] = messages
+		}
+
+		// every locale translates the English messages with the same parameters
+		en := catalogs["en"]
+		require.NotEmpty(t, en, dir)
+		for locale, messages := range catalogs {
+			tag := dir + "/" + locale
+			assert.Equal(t, localeKeys(en), localeKeys(messages), tag)
+			for key, message := range messages {
+				assert.Equal(t, placeholders(en[key]), placeholders(message), tag+": "+key)
+			}
+		}
+	}
+}
+
+var placeholderRegex = regexp.MustCompile(`{{[^}]*}}`)
+
+func placeholders(message string) []string {
+	p := placeholderRegex.FindAllString(message, -1)
+	sort.Strings(p)
+	return p
+}
+
+func localeKeys(messages map[string]string) []string {
+	keys := make([]string, 0, len(messages))
+	for key := range messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -67,6 +67,10 @@ func (r MapRule) Validate(m interface{}) error {
 func (r MapRule) ValidateWithContext(ctx context.Context, m interface{}) error {
 	value := reflect.ValueOf(m)
 	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			// treat a nil pointer as valid
+			return nil
+		}
 		value = value.Elem()
 	}
 	if value.Kind() != reflect.Map {
@@ -106,7 +110,7 @@ func (r MapRule) ValidateWithContext(ctx context.Context, m interface{}) error {
 			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
 				return err
 			}
-			errs[getErrorKeyName(kr.key)] = err
+			errs[getErrorKeyName(kr.key)] = withErrorField(err, getErrorKeyName(kr.key))
 		}
 		if !r.allowExtraKeys {
 			delete(extraKeys, kr.key)
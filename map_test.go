@@ -40,6 +40,7 @@ func TestMap(t *testing.T) {
 		{"t3.3", m0, []*KeyRules{}, ""},
 		{"t3.4", &m0, []*KeyRules{}, ""},
 		{"t3.5", 123, []*KeyRules{}, ErrNotMap.Error()},
+		{"t3.6", (*map[string]interface{})(nil), []*KeyRules{Key("A", Required)}, ""},
 		// invalid key spec
 		{"t4.1", m1, []*KeyRules{Key(123)}, "123: key not the correct type."},
 		{"t4.2", m1, []*KeyRules{Key("X")}, "X: required key is missing."},
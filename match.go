@@ -8,23 +8,45 @@ import (
 	"regexp"
 )
 
-// ErrMatchInvalid is the error that returns in case of invalid format.
-var ErrMatchInvalid = NewError("validation_match_invalid", "must be in a valid format")
+var (
+	// ErrMatchInvalid is the error that returns in case of invalid format.
+	ErrMatchInvalid = NewError("validation_match_invalid", "must be in a valid format")
+	// ErrMatchInvalidExample is the error that returns in case of invalid format for a rule with an example value.
+	ErrMatchInvalidExample = NewError("validation_match_invalid_example", "must be in a valid format (e.g. {{.example}})")
+)
 
 // Match returns a validation rule that checks if a value matches the specified regular expression.
 // This rule should only be used for validating strings and byte slices, or a validation error will be reported.
+// A value implementing encoding.TextMarshaler (e.g. net.IP) is matched against its text form.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+//
+// Note that, like regexp.MatchString, the value is valid if the regular expression matches any part of it,
+// unless the pattern is anchored. For example, Match(regexp.MustCompile("[0-9]{5}")) accepts "abc12345xyz".
+// Use MatchFull to require the whole value to match.
+//
+// The error returned by the rule has the "pattern" parameter set to the regular expression.
 func Match(re *regexp.Regexp) MatchRule {
-	return MatchRule{
-		re:  re,
-		err: ErrMatchInvalid,
-	}
+	r := MatchRule{re: re}
+	r.err = r.buildError()
+	return r
+}
+
+// MatchFull returns a validation rule that checks if a value matches the specified regular expression in full.
+// It is equivalent to Match with the pattern wrapped as "^(?:pattern)$", so MatchFull(regexp.MustCompile("[0-9]{5}"))
+// accepts "12345" but not "abc12345xyz". Please refer to Match for more details.
+func MatchFull(re *regexp.Regexp) MatchRule {
+	return Match(regexp.MustCompile(`^(?:` + re.String() + `)$`))
 }
 
 // MatchRule is a validation rule that checks if a value matches the specified regular expression.
 type MatchRule struct {
-	re  *regexp.Regexp
-	err Error
+	re      *regexp.Regexp
+	example string
+	err     Error
+	// customErr and message record the error and the message set by ErrorObject and Error, which are kept
+	// when err is rebuilt for a new example.
+	customErr Error
+	message   string
 }
 
 // Validate checks if the given value is valid or not.
@@ -43,14 +65,54 @@ func (r MatchRule) Validate(value interface{}) error {
 	return r.err
 }
 
+// validateString checks a string without reflection. It returns the same result as Validate.
+func (r MatchRule) validateString(value string) error {
+	if value == "" || r.re.MatchString(value) {
+		return nil
+	}
+	return r.err
+}
+
+// Example sets an example of a valid value, which is shown in the error message to help users fix the value,
+// e.g. Match(regexp.MustCompile(`^[A-Z]{2}-[0-9]{4}$`)).Example("AB-1234") reports
+// "must be in a valid format (e.g. AB-1234)". The error also has the "example" parameter.
+// An empty example restores the plain message. An error set by ErrorObject is not changed.
+func (r MatchRule) Example(example string) MatchRule {
+	r.example = example
+	r.err = r.buildError()
+	return r
+}
+
 // Error sets the error message for the rule.
 func (r MatchRule) Error(message string) MatchRule {
-	r.err = r.err.SetMessage(message)
+	if r.customErr != nil {
+		r.customErr = r.customErr.SetMessage(message)
+	} else {
+		r.message = message
+	}
+	r.err = r.buildError()
 	return r
 }
 
 // ErrorObject sets the error struct for the rule.
 func (r MatchRule) ErrorObject(err Error) MatchRule {
-	r.err = err
+	r.customErr, r.message = err, ""
+	r.err = r.buildError()
 	return r
 }
+
+// buildError returns the error set by ErrorObject, or the default error of the rule, which depends on whether
+// an example is set, with the message set by Error.
+func (r MatchRule) buildError() Error {
+	if r.customErr != nil {
+		return r.customErr
+	}
+	err := ErrMatchInvalid.SetParams(map[string]interface{}{"pattern": r.re.String()})
+	if r.example != "" {
+		err = ErrMatchInvalidExample.SetParams(map[string]interface{}{"pattern": r.re.String(), "example": r.example})
+	}
+	if r.message != "" {
+		err = err.SetMessage(r.message)
+	}
+	return err
+}
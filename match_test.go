@@ -5,6 +5,7 @@
 package valid
 
 import (
+	"net"
 	"regexp"
 	"testing"
 
@@ -27,6 +28,14 @@ func TestMatch(t *testing.T) {
 		{"t6", "[a-z]+", []byte("123"), "must be in a valid format"},
 		{"t7", "[a-z]+", []byte(""), ""},
 		{"t8", "[a-z]+", nil, ""},
+		{"t9", `^10\.`, net.ParseIP("10.0.0.1"), ""},
+		{"t10", `^10\.`, net.ParseIP("192.168.0.1"), "must be in a valid format"},
+		{"t11", `^10\.`, net.IP(nil), ""},
+		{"t12", "^[a-z]+$", MyString("abc"), ""},
+		{"t13", "^Active$", statusActive, ""},
+		{"t14", "^Active$", statusInactive, "must be in a valid format"},
+		{"t15", "^[0-9a-f]+$", hexBytes("xyz"), ""},
+		{"t16", "^string", stringText{"abc"}, ""},
 	}
 
 	for _, test := range tests {
@@ -36,6 +45,33 @@ func TestMatch(t *testing.T) {
 	}
 }
 
+func TestMatchFull(t *testing.T) {
+	tests := []struct {
+		tag      string
+		re       string
+		value    interface{}
+		matchErr string
+		fullErr  string
+	}{
+		{"t1", "[0-9]{5}", "12345", "", ""},
+		{"t2", "[0-9]{5}", "", "", ""},
+		{"t3", "[0-9]{5}", "abc12345xyz", "", "must be in a valid format"},
+		{"t4", "[0-9]{5}", "123456", "", "must be in a valid format"},
+		{"t5", "[0-9]{5}", []byte("12345"), "", ""},
+		{"t6", "[0-9]{5}", []byte("x12345"), "", "must be in a valid format"},
+		{"t7", "a|ab", "ab", "", ""},
+		{"t8", "^[0-9]+$", "123", "", ""},
+		{"t9", "(?i)abc", "ABC", "", ""},
+		{"t10", "[0-9]{5}", "abc", "must be in a valid format", "must be in a valid format"},
+	}
+
+	for _, test := range tests {
+		re := regexp.MustCompile(test.re)
+		assertError(t, test.matchErr, Match(re).Validate(test.value), test.tag)
+		assertError(t, test.fullErr, MatchFull(re).Validate(test.value), test.tag)
+	}
+}
+
 func Test_MatchRule_Error(t *testing.T) {
 	r := Match(regexp.MustCompile("[a-z]+"))
 	assert.Equal(t, "must be in a valid format", r.Validate("13").Error())
@@ -53,3 +89,36 @@ func TestMatchRule_ErrorObject(t *testing.T) {
 	assert.Equal(t, err.Code(), r.err.Code())
 	assert.Equal(t, err.Message(), r.err.Message())
 }
+
+func TestMatch_Params(t *testing.T) {
+	err := Match(regexp.MustCompile("^[0-9]{5}$")).Validate("abc")
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"pattern": "^[0-9]{5}$"}, err.(Error).Params())
+		assert.Equal(t, "must be in a valid format", err.Error())
+	}
+	err = MatchFull(regexp.MustCompile("[0-9]{5}")).Error("must match {{.pattern}}").Validate("abc")
+	assertError(t, "must match ^(?:[0-9]{5})$", err, "t1")
+}
+
+func TestMatchRule_Example(t *testing.T) {
+	r := Match(regexp.MustCompile(`^[A-Z]{2}-[0-9]{4}$`)).Example("AB-1234")
+	assert.Nil(t, r.Validate("XY-0001"))
+	assert.Nil(t, r.Validate(""))
+	err := r.Validate("xy-1")
+	assertError(t, "must be in a valid format (e.g. AB-1234)", err, "t1")
+	if e, ok := err.(Error); assert.True(t, ok) {
+		assert.Equal(t, "validation_match_invalid_example", e.Code())
+		assert.Equal(t, map[string]interface{}{"pattern": `^[A-Z]{2}-[0-9]{4}$`, "example": "AB-1234"}, e.Params())
+	}
+
+	// the plain message is used without an example
+	assertError(t, "must be in a valid format", Match(regexp.MustCompile(`^[0-9]+$`)).Validate("a"), "t2")
+	assertError(t, "must be in a valid format", r.Example("").Validate("a"), "t3")
+	assertError(t, "should look like 1", r.Example("1").Error("should look like {{.example}}").Validate("a"), "t4")
+	assertError(t, "must be in a valid format (e.g. 12345)", MatchFull(regexp.MustCompile(`[0-9]{5}`)).Example("12345").Validate("a"), "t5")
+
+	// an error set before Example is kept
+	assertError(t, "should look like 1", r.Error("should look like {{.example}}").Example("1").Validate("a"), "t6")
+	custom := NewError("code", "abc")
+	assert.Equal(t, custom, r.ErrorObject(custom).Example("1").Validate("a"), "t7")
+}
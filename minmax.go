@@ -37,8 +37,15 @@ const (
 
 // Min returns a validation rule that checks if a value is greater or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly greater than the specified value.
-// Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// A number of any int, uint or float type can be compared with a threshold of any of these types (e.g. an int32 value
+// with Min(18)). Integers are compared exactly, including uint64 values beyond the range of int64, while a comparison
+// involving a float is performed in float64, which may lose precision for integers beyond 2^53.
+// The math/big numbers (*big.Int, *big.Rat and *big.Float) are supported as both values and thresholds, and are
+// compared exactly with any other number.
+// Only number and time.Time types are supported.
+// Times are compared as instants regardless of their locations, and the threshold may also be given as a *time.Time.
+// A zero (or nil) time threshold is considered unset, so the rule accepts any value, e.g. when a Min(e.StartsAt)
+// bound refers to another field that has not been filled in.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Min(min interface{}) ThresholdRule {
 	return ThresholdRule{
@@ -51,8 +58,15 @@ func Min(min interface{}) ThresholdRule {
 
 // Max returns a validation rule that checks if a value is less or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly less than the specified value.
-// Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// A number of any int, uint or float type can be compared with a threshold of any of these types (e.g. an int32 value
+// with Min(18)). Integers are compared exactly, including uint64 values beyond the range of int64, while a comparison
+// involving a float is performed in float64, which may lose precision for integers beyond 2^53.
+// The math/big numbers (*big.Int, *big.Rat and *big.Float) are supported as both values and thresholds, and are
+// compared exactly with any other number.
+// Only number and time.Time types are supported.
+// Times are compared as instants regardless of their locations, and the threshold may also be given as a *time.Time.
+// A zero (or nil) time threshold is considered unset, so the rule accepts any value, e.g. when a Min(e.StartsAt)
+// bound refers to another field that has not been filled in.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Max(max interface{}) ThresholdRule {
 	return ThresholdRule{
@@ -82,52 +96,44 @@ func (r ThresholdRule) Validate(value interface{}) error {
 	}
 
 	rv := reflect.ValueOf(r.threshold)
-	switch rv.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		v, err := ToInt(value)
-		if err != nil {
-			return err
+	if isNumber(rv) {
+		vv := reflect.ValueOf(value)
+		if !isNumber(vv) {
+			return r.conversionError(rv, value)
 		}
-		if r.compareInt(rv.Int(), v) {
+		if c, ok := compareNumbers(vv, rv); ok && r.compare(c) {
 			return nil
 		}
+		return r.err.SetParams(map[string]interface{}{"threshold": r.threshold})
+	}
 
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		v, err := ToUint(value)
-		if err != nil {
-			return err
-		}
-		if r.compareUint(rv.Uint(), v) {
-			return nil
-		}
+	t, ok := timeThreshold(r.threshold)
+	if !ok {
+		return fmt.Errorf("type not supported: %v", rv.Type())
+	}
+	v, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+	}
+	if t.IsZero() || v.IsZero() || r.compareTime(t, v) {
+		return nil
+	}
 
-	case reflect.Float32, reflect.Float64:
-		v, err := ToFloat(value)
-		if err != nil {
-			return err
-		}
-		if r.compareFloat(rv.Float(), v) {
-			return nil
-		}
+	return r.err.SetParams(map[string]interface{}{"threshold": t})
+}
 
-	case reflect.Struct:
-		t, ok := r.threshold.(time.Time)
-		if !ok {
-			return fmt.Errorf("type not supported: %v", rv.Type())
-		}
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
-		}
-		if v.IsZero() || r.compareTime(t, v) {
-			return nil
+// timeThreshold returns the time of a time.Time or *time.Time threshold. A nil pointer gives the zero time.
+func timeThreshold(threshold interface{}) (time.Time, bool) {
+	switch t := threshold.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, true
 		}
-
-	default:
-		return fmt.Errorf("type not supported: %v", rv.Type())
+		return *t, true
 	}
-
-	return r.err.SetParams(map[string]interface{}{"threshold": r.threshold})
+	return time.Time{}, false
 }
 
 // Error sets the error message for the rule.
@@ -142,43 +148,35 @@ func (r ThresholdRule) ErrorObject(err Error) ThresholdRule {
 	return r
 }
 
-func (r ThresholdRule) compareInt(threshold, value int64) bool {
+// compare checks the result of comparing the value with the threshold against the operator.
+func (r ThresholdRule) compare(c int) bool {
 	switch r.operator {
 	case greaterThan:
-		return value > threshold
+		return c > 0
 	case greaterEqualThan:
-		return value >= threshold
+		return c >= 0
 	case lessThan:
-		return value < threshold
+		return c < 0
 	default:
-		return value <= threshold
+		return c <= 0
 	}
 }
 
-func (r ThresholdRule) compareUint(threshold, value uint64) bool {
-	switch r.operator {
-	case greaterThan:
-		return value > threshold
-	case greaterEqualThan:
-		return value >= threshold
-	case lessThan:
-		return value < threshold
-	default:
-		return value <= threshold
+// conversionError returns the error for a value that cannot be compared with the numeric threshold.
+func (r ThresholdRule) conversionError(threshold reflect.Value, value interface{}) error {
+	if isBigNumber(threshold) {
+		return fmt.Errorf("cannot convert %v to %v", reflect.TypeOf(value), threshold.Type())
 	}
-}
-
-func (r ThresholdRule) compareFloat(threshold, value float64) bool {
-	switch r.operator {
-	case greaterThan:
-		return value > threshold
-	case greaterEqualThan:
-		return value >= threshold
-	case lessThan:
-		return value < threshold
+	var err error
+	switch numberKind(threshold.Kind()) {
+	case reflect.Int64:
+		_, err = ToInt(value)
+	case reflect.Uint64:
+		_, err = ToUint(value)
 	default:
-		return value <= threshold
+		_, err = ToFloat(value)
 	}
+	return err
 }
 
 func (r ThresholdRule) compareTime(threshold, value time.Time) bool {
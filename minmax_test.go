@@ -5,6 +5,9 @@
 package valid
 
 import (
+	"fmt"
+	"math"
+	"math/big"
 	"testing"
 	"time"
 
@@ -55,6 +58,11 @@ func TestMin(t *testing.T) {
 		{"t4.6", date20000601, true, 1, "cannot convert int to time.Time"},
 		{"t4.7", struct{}{}, false, 1, "type not supported: struct {}"},
 		{"t4.8", date0, false, date20000601, ""},
+		{"t4.9", &date20000601, false, date20000101, "must be no less than 2000-06-01 00:00:00 +0000 UTC"},
+		{"t4.10", &date20000601, false, date20001201, ""},
+		{"t4.11", (*time.Time)(nil), false, date20000101, ""},
+		{"t4.12", date20000601, false, date20000601.In(time.FixedZone("UTC-5", -5*3600)), ""},
+		{"t4.13", date20000601, false, time.Date(2000, 6, 1, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)), "must be no less than 2000-06-01 00:00:00 +0000 UTC"},
 	}
 
 	for _, test := range tests {
@@ -67,6 +75,87 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestThresholdRule_NumericKinds(t *testing.T) {
+	type age int16
+	values := []interface{}{
+		int(18), int8(18), int16(18), int32(18), int64(18),
+		uint(18), uint8(18), uint16(18), uint32(18), uint64(18), uintptr(18),
+		float32(18), float64(18), age(18),
+	}
+	thresholds := []interface{}{
+		int(18), int8(18), int16(18), int32(18), int64(18),
+		uint(18), uint8(18), uint16(18), uint32(18), uint64(18), uintptr(18),
+		float32(18), float64(18), age(18),
+	}
+
+	for _, threshold := range thresholds {
+		for _, value := range values {
+			tag := fmt.Sprintf("%T vs %T", value, threshold)
+			assert.Nil(t, Min(threshold).Validate(value), tag)
+			assert.Nil(t, Max(threshold).Validate(value), tag)
+			assertError(t, fmt.Sprintf("must be greater than %v", threshold), Min(threshold).Exclusive().Validate(value), tag)
+			assertError(t, fmt.Sprintf("must be less than %v", threshold), Max(threshold).Exclusive().Validate(value), tag)
+		}
+	}
+
+	tests := []struct {
+		tag   string
+		rule  ThresholdRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Min(0), int8(-1), "must be no less than 0"},
+		{"t2", Min(uint(0)), int8(-1), "must be no less than 0"},
+		{"t3", Max(-1), uint64(math.MaxUint64), "must be no greater than -1"},
+		{"t4", Max(int64(math.MaxInt64)), uint64(math.MaxInt64) + 1, "must be no greater than 9223372036854775807"},
+		{"t5", Max(uint64(math.MaxUint64)), int64(math.MaxInt64), ""},
+		{"t6", Min(uint64(math.MaxUint64)), int64(-1), "must be no less than 18446744073709551615"},
+		{"t7", Min(1), 0.5, "must be no less than 1"},
+		{"t8", Max(1.5), uint8(2), "must be no greater than 1.5"},
+		{"t9", Max(10), math.NaN(), "must be no greater than 10"},
+		{"t10", Min(10), "abc", "cannot convert string to int64"},
+		{"t11", Min(10.5), true, "cannot convert bool to float64"},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestThresholdRule_BigNumbers(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	huger := new(big.Int).Add(huge, big.NewInt(1))
+	var nilInt *big.Int
+	tests := []struct {
+		tag   string
+		rule  ThresholdRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Min(huge), huger, ""},
+		{"t2", Min(huge), huge, ""},
+		{"t3", Min(huge).Exclusive(), huge, "must be greater than 123456789012345678901234567890"},
+		{"t4", Max(huge), huger, "must be no greater than 123456789012345678901234567890"},
+		{"t5", Max(huge), int64(math.MaxInt64), ""},
+		{"t6", Min(huge), uint64(math.MaxUint64), "must be no less than 123456789012345678901234567890"},
+		{"t7", Min(0), big.NewInt(-1), "must be no less than 0"},
+		{"t8", Max(uint64(math.MaxUint64)), huge, "must be no greater than 18446744073709551615"},
+		{"t9", Min(big.NewRat(1, 3)), 0.33, "must be no less than 1/3"},
+		{"t10", Min(big.NewRat(1, 3)), big.NewFloat(0.34), ""},
+		{"t11", Max(big.NewFloat(1.5)), huge, "must be no greater than 1.5"},
+		{"t12", Max(1.5), big.NewRat(3, 2), ""},
+		{"t13", Max(10), new(big.Float).SetInf(false), "must be no greater than 10"},
+		{"t14", Min(10), big.NewInt(0), ""},
+		{"t15", Min(10), nilInt, ""},
+		{"t16", Min(huge), "abc", "cannot convert string to *big.Int"},
+		{"t17", Min(huge), *huger, ""},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
 func TestMinError(t *testing.T) {
 	r := Min(10)
 	assert.Equal(t, "must be no less than 10", r.Validate(9).Error())
@@ -117,6 +206,11 @@ func TestMax(t *testing.T) {
 		{"t4.4", date20000601, false, date0, ""},
 		{"t4.5", date20000601, true, date20000601, "must be less than 2000-06-01 00:00:00 +0000 UTC"},
 		{"t4.6", date20000601, true, 1, "cannot convert int to time.Time"},
+		{"t4.7", date0, false, date20001201, ""},
+		{"t4.8", &date20000601, false, date20001201, "must be no greater than 2000-06-01 00:00:00 +0000 UTC"},
+		{"t4.9", (*time.Time)(nil), false, date20001201, ""},
+		{"t4.10", date20000601, true, date20000601.In(time.FixedZone("UTC+9", 9*3600)), "must be less than 2000-06-01 00:00:00 +0000 UTC"},
+		{"t4.11", date20000601, false, time.Date(2000, 5, 31, 23, 0, 0, 0, time.FixedZone("UTC-2", -2*3600)), "must be no greater than 2000-06-01 00:00:00 +0000 UTC"},
 	}
 
 	for _, test := range tests {
@@ -146,3 +240,48 @@ func TestThresholdRule_ErrorObject(t *testing.T) {
 	assert.Equal(t, err.Code(), r.err.Code())
 	assert.Equal(t, err.Message(), r.err.Message())
 }
+
+type event struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+func (e event) Validate() error {
+	return ValidateStruct(&e,
+		Field(&e.EndsAt, Min(e.StartsAt).Exclusive()),
+	)
+}
+
+func TestThresholdRule_EventTimes(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	newYork := time.FixedZone("EST", -5*3600)
+	start := time.Date(2000, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag   string
+		event event
+		err   string
+	}{
+		{"t1", event{start, start.Add(time.Hour)}, ""},
+		{"t2", event{start, start}, "EndsAt: must be greater than 2000-06-01 10:00:00 +0000 UTC."},
+		{"t3", event{start, start.Add(-time.Hour)}, "EndsAt: must be greater than 2000-06-01 10:00:00 +0000 UTC."},
+		// the same instants in different locations
+		{"t4", event{start.In(tokyo), start.Add(time.Hour).In(newYork)}, ""},
+		{"t5", event{start.In(newYork), start.In(tokyo)}, "EndsAt: must be greater than 2000-06-01 05:00:00 -0500 EST."},
+		// 11:00 in Tokyo is before 10:00 in UTC although its wall clock is later
+		{"t6", event{start, time.Date(2000, 6, 1, 11, 0, 0, 0, tokyo)}, "EndsAt: must be greater than 2000-06-01 10:00:00 +0000 UTC."},
+		// zero times are unset
+		{"t7", event{time.Time{}, start}, ""},
+		{"t8", event{start, time.Time{}}, ""},
+	}
+	for _, test := range tests {
+		assertError(t, test.err, Validate(test.event), test.tag)
+	}
+
+	// events overlap if one starts before the other ends
+	a := event{start, start.Add(2 * time.Hour)}
+	b := event{start.Add(time.Hour).In(tokyo), start.Add(3 * time.Hour).In(tokyo)}
+	c := event{start.Add(2 * time.Hour).In(newYork), start.Add(4 * time.Hour).In(newYork)}
+	assertError(t, "must be no less than 2000-06-01 12:00:00 +0000 UTC", Validate(b.StartsAt, Min(a.EndsAt)), "t9")
+	assertError(t, "", Validate(c.StartsAt, Min(&a.EndsAt)), "t10")
+}
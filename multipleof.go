@@ -2,6 +2,7 @@ package valid
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 )
 
@@ -9,7 +10,9 @@ import (
 var ErrMultipleOfInvalid = NewError("validation_multiple_of_invalid", "must be multiple of {{.base}}")
 
 // MultipleOf returns a validation rule that checks if a value is a multiple of the "base" value.
-// Note that "base" should be of integer type.
+// Note that "base" should be of integer type. The value may be of any integer type, not necessarily the same as "base"
+// (e.g. an int32 value with MultipleOf(5)). Negative values are checked by their absolute values, which are computed in
+// uint64 so that no overflow happens. Either of them may also be a *big.Int, e.g. for values beyond the range of int64.
 func MultipleOf(base interface{}) MultipleOfRule {
 	return MultipleOfRule{
 		base: base,
@@ -38,28 +41,67 @@ func (r MultipleOfRule) ErrorObject(err Error) MultipleOfRule {
 // Validate checks if the value is a multiple of the "base" value.
 func (r MultipleOfRule) Validate(value interface{}) error {
 	rv := reflect.ValueOf(r.base)
-	switch rv.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		v, err := ToInt(value)
-		if err != nil {
-			return err
-		}
-		if v%rv.Int() == 0 {
-			return nil
-		}
+	if vv := reflect.ValueOf(value); isBigNumber(rv) || isBigNumber(vv) {
+		return r.validateBig(rv, vv)
+	}
+	var base uint64
+	baseKind := numberKind(rv.Kind())
+	switch baseKind {
+	case reflect.Int64:
+		base = absInt(rv.Int())
+	case reflect.Uint64:
+		base = rv.Uint()
+	default:
+		return fmt.Errorf("type not supported: %v", rv.Type())
+	}
 
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		v, err := ToUint(value)
-		if err != nil {
+	vv := reflect.ValueOf(value)
+	var v uint64
+	switch numberKind(vv.Kind()) {
+	case reflect.Int64:
+		v = absInt(vv.Int())
+	case reflect.Uint64:
+		v = vv.Uint()
+	default:
+		// report the value as not convertible to the type of the base
+		if baseKind == reflect.Uint64 {
+			_, err := ToUint(value)
 			return err
 		}
+		_, err := ToInt(value)
+		return err
+	}
+	if v%base == 0 {
+		return nil
+	}
 
-		if v%rv.Uint() == 0 {
-			return nil
-		}
-	default:
+	return r.err.SetParams(map[string]interface{}{"base": r.base})
+}
+
+// validateBig checks if the value is a multiple of the base when either of them is a math/big number.
+func (r MultipleOfRule) validateBig(rv, vv reflect.Value) error {
+	base, ok := toBigInt(rv)
+	if !ok {
 		return fmt.Errorf("type not supported: %v", rv.Type())
 	}
+	if !vv.IsValid() || vv.Kind() == reflect.Ptr && vv.IsNil() {
+		return nil
+	}
+	v, ok := toBigInt(vv)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to %v", vv.Type(), bigIntType)
+	}
+	if new(big.Int).Rem(v, base).Sign() == 0 {
+		return nil
+	}
 
 	return r.err.SetParams(map[string]interface{}{"base": r.base})
 }
+
+// absInt returns the absolute value of an int64 as a uint64, which also holds the absolute value of math.MinInt64.
+func absInt(v int64) uint64 {
+	if v < 0 {
+		return uint64(-v)
+	}
+	return uint64(v)
+}
@@ -5,6 +5,8 @@
 package valid
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +25,47 @@ func TestMultipleOf(t *testing.T) {
 	assert.Equal(t, "must be multiple of 10", r3.Validate(uint(11)).Error())
 	assert.Equal(t, nil, r3.Validate(uint(20)))
 	assert.Equal(t, "cannot convert float32 to uint64", r3.Validate(float32(20)).Error())
+	assert.Equal(t, "cannot convert string to uint64", r3.Validate("20").Error())
+}
+
+func TestMultipleOf_NumericKinds(t *testing.T) {
+	values := []interface{}{
+		int(20), int8(20), int16(20), int32(20), int64(20), int(-20),
+		uint(20), uint8(20), uint16(20), uint32(20), uint64(20), uintptr(20),
+	}
+	for _, base := range []interface{}{int(5), int8(-5), uint(5), uint64(5)} {
+		for _, value := range values {
+			assert.Nil(t, MultipleOf(base).Validate(value), "%T vs %T", value, base)
+		}
+		assert.NotNil(t, MultipleOf(base).Validate(int32(21)))
+		assert.NotNil(t, MultipleOf(base).Validate(uint8(21)))
+	}
+	assert.Nil(t, MultipleOf(2).Validate(int64(math.MinInt64)))
+	assert.Nil(t, MultipleOf(uint64(math.MaxUint64)).Validate(uint64(math.MaxUint64)))
+}
 
+func TestMultipleOf_BigInt(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000000000000", 10)
+	tests := []struct {
+		tag   string
+		base  interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", 10, huge, ""},
+		{"t2", 7, huge, "must be multiple of 7"},
+		{"t3", huge, new(big.Int).Mul(huge, big.NewInt(-3)), ""},
+		{"t4", huge, int64(math.MaxInt64), "must be multiple of 100000000000000000000000000000"},
+		{"t5", big.NewInt(5), uint8(20), ""},
+		{"t6", big.NewInt(5), *big.NewInt(21), "must be multiple of 5"},
+		{"t7", big.NewInt(5), (*big.Int)(nil), ""},
+		{"t8", big.NewInt(5), 2.5, "cannot convert float64 to big.Int"},
+		{"t9", big.NewRat(1, 2), big.NewInt(2), "type not supported: *big.Rat"},
+	}
+	for _, test := range tests {
+		err := MultipleOf(test.base).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
 }
 
 func Test_MultipleOf_Error(t *testing.T) {
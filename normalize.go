@@ -0,0 +1,108 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"strings"
+)
+
+// Normalizer is implemented by rules that transform the value being validated rather than check it.
+// ValidateAndNormalize passes the value returned by Normalize to the rules following it.
+type Normalizer interface {
+	// Normalize returns the normalized form of the given value.
+	Normalize(value interface{}) interface{}
+}
+
+// NormalizeRule is a rule that transforms the value being validated when used with ValidateAndNormalize.
+type NormalizeRule struct {
+	f func(value interface{}) interface{}
+}
+
+// Normalize returns a rule that transforms the value being validated with the given function.
+// The transformed value is seen by the rules following it, and is returned by ValidateAndNormalize.
+// Since Validate and ValidateStruct have no way to pass the transformed value on, the rule does nothing
+// when used with them.
+func Normalize(f func(value interface{}) interface{}) NormalizeRule {
+	return NormalizeRule{f: f}
+}
+
+// TrimSpace is a rule that removes the leading and trailing white space from a string when used with ValidateAndNormalize.
+// Values other than strings are left unchanged.
+var TrimSpace = Normalize(func(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return value
+})
+
+// Validate does nothing. The rule only takes effect with ValidateAndNormalize.
+func (r NormalizeRule) Validate(interface{}) error {
+	return nil
+}
+
+// Normalize returns the value transformed by the rule.
+func (r NormalizeRule) Normalize(value interface{}) interface{} {
+	return r.f(value)
+}
+
+// ValidateAndNormalize validates the given value like Validate, except that the value is threaded through the rules
+// implementing Normalizer: each of them replaces the value seen by the rules following it. The final value is
+// returned together with the validation error, if any, so a single value can be sanitized and validated in one call:
+//
+//	email, err := valid.ValidateAndNormalize(input, valid.TrimSpace, valid.Required, is.Email)
+//
+// Rules that do not implement Normalizer leave the value unchanged. Note that only the rules passed directly are
+// considered; a normalizing rule nested within another rule (e.g. When) is not applied. Like Validate, the rules
+// following a warning (see Warn) are still applied.
+func ValidateAndNormalize(value interface{}, rules ...Rule) (interface{}, error) {
+	return validateAndNormalize(nil, value, rules)
+}
+
+// ValidateAndNormalizeWithContext validates and normalizes the given value with the given context.
+// The only difference between ValidateAndNormalizeWithContext and ValidateAndNormalize is that the former will
+// validate the value with the provided context.
+// Please refer to ValidateAndNormalize for the detailed instructions on how to use this function.
+func ValidateAndNormalizeWithContext(ctx context.Context, value interface{}, rules ...Rule) (interface{}, error) {
+	return validateAndNormalize(ctx, value, rules)
+}
+
+// validateAndNormalize validates and normalizes the value. If ctx is nil, the value is validated without a context.
+func validateAndNormalize(ctx context.Context, value interface{}, rules []Rule) (interface{}, error) {
+	var warning error
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return value, warning
+		}
+		if n, ok := rule.(Normalizer); ok {
+			value = n.Normalize(value)
+			continue
+		}
+		var err error
+		if rc, ok := rule.(RuleWithContext); ok && ctx != nil {
+			err = rc.ValidateWithContext(ctx, value)
+		} else {
+			err = rule.Validate(value)
+		}
+		if err != nil {
+			if err = wrapRuleError(ctx, rule, value, err); !isWarning(err) {
+				return value, err
+			} else if warning == nil {
+				warning = err
+			}
+		}
+	}
+
+	var err error
+	if ctx == nil {
+		err = Validate(value)
+	} else {
+		err = ValidateWithContext(ctx, value)
+	}
+	if err != nil {
+		return value, err
+	}
+	return value, warning
+}
@@ -0,0 +1,92 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAndNormalize(t *testing.T) {
+	lower := Normalize(func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			return strings.ToLower(s)
+		}
+		return value
+	})
+	tests := []struct {
+		tag   string
+		value interface{}
+		rules []Rule
+		want  interface{}
+		err   string
+	}{
+		{"t1", "  Abc ", []Rule{TrimSpace, lower, In("abc")}, "abc", ""},
+		{"t2", "  ", []Rule{TrimSpace, Required}, "", "cannot be blank"},
+		{"t3", " abc ", []Rule{Length(1, 3), TrimSpace}, " abc ", "the length must be between 1 and 3"},
+		{"t4", " ABC ", []Rule{TrimSpace, Length(1, 3), lower}, "abc", ""},
+		{"t5", 123, []Rule{TrimSpace, Min(100)}, 123, ""},
+		{"t6", " x ", []Rule{TrimSpace, Skip, lower}, "x", ""},
+		{"t7", " x ", nil, " x ", ""},
+		{"t8", "  ", []Rule{TrimSpace, Required, lower}, "", "cannot be blank"},
+	}
+
+	for _, test := range tests {
+		value, err := ValidateAndNormalize(test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+		assert.Equal(t, test.want, value, test.tag)
+		value, err = ValidateAndNormalizeWithContext(context.Background(), test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+		assert.Equal(t, test.want, value, test.tag)
+	}
+
+	// normalizing rules do nothing with Validate
+	assertError(t, "the length must be between 1 and 3", Validate(" abc ", TrimSpace, Length(1, 3)), "t9")
+
+	// the normalized value is validated if it is Validatable
+	value, err := ValidateAndNormalize("abc", Normalize(func(value interface{}) interface{} { return String123(value.(string)) }))
+	assertError(t, "error 123", err, "t10")
+	assert.Equal(t, String123("abc"), value)
+}
+
+func TestValidateAndNormalizeWithContext(t *testing.T) {
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if value != ctx.Value(contains) {
+			return errors.New("unexpected value")
+		}
+		return nil
+	})
+	ctx := context.WithValue(context.Background(), contains, "abc")
+	value, err := ValidateAndNormalizeWithContext(ctx, " abc ", TrimSpace, rule)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", value)
+	_, err = ValidateAndNormalizeWithContext(ctx, " xyz ", TrimSpace, rule)
+	assertError(t, "unexpected value", err, "t1")
+}
+
+func TestValidateAndNormalize_Warnings(t *testing.T) {
+	weak := Warn(Length(5, 0))
+	value, err := ValidateAndNormalize(" abc ", TrimSpace, weak, Required)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", value)
+	_, err = ValidateAndNormalize(" abc ", TrimSpace, weak, In("zzz"))
+	assertError(t, "must be a valid value", err, "t1")
+
+	// a requested warning does not stop the rules after it
+	ctx := WithWarnings(context.Background())
+	value, err = ValidateAndNormalizeWithContext(ctx, " abc ", TrimSpace, weak, Normalize(func(value interface{}) interface{} {
+		return value.(string) + "!"
+	}))
+	assertError(t, "the length must be no less than 5", err, "t2")
+	assert.Equal(t, SeverityWarning, SeverityOf(err))
+	assert.Equal(t, "abc!", value)
+	_, err = ValidateAndNormalizeWithContext(ctx, " abc ", TrimSpace, weak, In("zzz"))
+	assertError(t, "must be a valid value", err, "t3")
+	assert.Equal(t, SeverityError, SeverityOf(err))
+}
@@ -8,12 +8,14 @@ package valid
 var ErrNotInInvalid = NewError("validation_not_in_invalid", "must not be in list")
 
 // NotIn returns a validation rule that checks if a value is absent from the given list of values.
-// Note that the value being checked and the possible range of values must be of the same type.
+// Note that the value being checked and the possible range of values must be of the same type,
+// except that numbers of different predeclared int, uint and float types are compared by their values.
+// Like In, the "values" parameter of the error is set to the values joined by ", ".
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func NotIn(values ...interface{}) NotInRule {
 	return NotInRule{
 		elements: values,
-		err:      ErrNotInInvalid,
+		err:      ErrNotInInvalid.SetParams(map[string]interface{}{"values": joinValues(values)}),
 	}
 }
 
@@ -31,7 +33,7 @@ func (r NotInRule) Validate(value interface{}) error {
 	}
 
 	for _, e := range r.elements {
-		if e == value {
+		if e == value || numbersEqual(e, value) {
 			return r.err
 		}
 	}
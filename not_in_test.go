@@ -53,3 +53,11 @@ func TestNotInRule_ErrorObject(t *testing.T) {
 	assert.Equal(t, err.Code(), r.err.Code())
 	assert.Equal(t, err.Message(), r.err.Message())
 }
+
+func TestNotIn_Params(t *testing.T) {
+	err := NotIn(1, 2).Validate(2)
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, map[string]interface{}{"values": "1, 2"}, err.(Error).Params())
+		assert.Equal(t, "must not be in list", err.Error())
+	}
+}
@@ -10,6 +10,10 @@ var ErrNotNilRequired = NewError("validation_not_nil_required", "is required")
 // NotNil is a validation rule that checks if a value is not nil.
 // NotNil only handles types including interface, pointer, slice, and map.
 // All other types are considered valid.
+//
+// Unlike Required, NotNil accepts an empty (but non-nil) slice or map. This is useful for data decoded from JSON,
+// where an absent or null field leaves a slice nil while `[]` produces an empty slice. Use NotNil to require that
+// the field is provided, and Required to also require it to be non-empty.
 var NotNil = notNilRule{}
 
 type notNilRule struct {
@@ -41,6 +41,29 @@ func TestNotNil(t *testing.T) {
 	}
 }
 
+func TestNotNil_EmptyVersusNil(t *testing.T) {
+	var nilSlice []string
+	var nilMap map[string]int
+	tests := []struct {
+		tag         string
+		value       interface{}
+		notNilErr   string
+		requiredErr string
+	}{
+		{"nil slice", nilSlice, "is required", "cannot be empty"},
+		{"empty slice", []string{}, "", "cannot be empty"},
+		{"populated slice", []string{"a"}, "", ""},
+		{"nil map", nilMap, "is required", "cannot be empty"},
+		{"empty map", map[string]int{}, "", "cannot be empty"},
+		{"populated map", map[string]int{"a": 1}, "", ""},
+	}
+
+	for _, test := range tests {
+		assertError(t, test.notNilErr, NotNil.Validate(test.value), test.tag)
+		assertError(t, test.requiredErr, Required.Validate(test.value), test.tag)
+	}
+}
+
 func Test_notNilRule_Error(t *testing.T) {
 	r := NotNil
 	assert.Equal(t, "is required", r.Validate(nil).Error())
@@ -0,0 +1,129 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"strings"
+)
+
+// ErrOr is the error that returns when a value fails all the rules of an Or rule.
+var ErrOr = NewError("validation_or", "must satisfy one of: {{.errors}}")
+
+// Or returns a validation rule that checks if a value passes at least one of the given rules, e.g. for a contact
+// field that may hold either an email address or a phone number:
+//
+//	valid.Field(&c.Contact, valid.Required, valid.Or(is.EmailFormat, is.E164))
+//
+// The rules are tried in order until one of them passes. If all of them fail, a single error is returned whose
+// "errors" parameter holds the messages of the errors of the rules joined by "; ", e.g.
+// "must satisfy one of: must be a valid email address; must be a valid E164 number".
+// Use And to require several rules to pass within one alternative. Unlike Validate, Or only applies the rules,
+// so a validatable value is not validated by its own Validate() method. Or without any rule accepts any value.
+func Or(rules ...Rule) OrRule {
+	return OrRule{
+		rules: rules,
+		err:   ErrOr,
+	}
+}
+
+// OrRule is a validation rule that checks if a value passes at least one of the specified rules.
+type OrRule struct {
+	rules []Rule
+	err   Error
+}
+
+// Validate checks if the given value passes at least one of the rules.
+func (r OrRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext checks if the given value passes at least one of the rules using the given context.
+func (r OrRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if len(r.rules) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(r.rules))
+	for _, rule := range r.rules {
+		err := applyRule(ctx, rule, value)
+		if err == nil {
+			return nil
+		}
+		if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+			return err
+		}
+		errs = append(errs, err)
+	}
+	return newOrError(r.err, errs)
+}
+
+// Error sets the error message for the rule.
+func (r OrRule) Error(message string) OrRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r OrRule) ErrorObject(err Error) OrRule {
+	r.err = err
+	return r
+}
+
+// orError is the error of an Or rule. It keeps the errors of the rules, so that its "errors" parameter is rebuilt
+// from their messages when the error is localized.
+type orError struct {
+	err  Error
+	errs []error
+}
+
+// newOrError returns the error of an Or rule whose "errors" parameter joins the messages of the given errors.
+func newOrError(err Error, errs []error) orError {
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return orError{
+		err:  err.SetParams(map[string]interface{}{"errors": strings.Join(messages, "; ")}),
+		errs: errs,
+	}
+}
+
+// Error returns the error message.
+func (e orError) Error() string {
+	return e.err.Error()
+}
+
+// Code returns the error code.
+func (e orError) Code() string {
+	return e.err.Code()
+}
+
+// Message returns the error message template.
+func (e orError) Message() string {
+	return e.err.Message()
+}
+
+// Params returns the error parameters.
+func (e orError) Params() map[string]interface{} {
+	return e.err.Params()
+}
+
+// SetMessage sets the error message.
+func (e orError) SetMessage(message string) Error {
+	return orError{err: e.err.SetMessage(message), errs: e.errs}
+}
+
+// SetParams sets the error parameters.
+func (e orError) SetParams(params map[string]interface{}) Error {
+	return orError{err: e.err.SetParams(params), errs: e.errs}
+}
+
+// applyRule validates the value with a single rule. If ctx is nil, the rule is applied without a context.
+func applyRule(ctx context.Context, rule Rule, value interface{}) error {
+	if rc, ok := rule.(RuleWithContext); ok && ctx != nil {
+		return rc.ValidateWithContext(ctx, value)
+	}
+	return rule.Validate(value)
+}
@@ -0,0 +1,67 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOr(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  OrRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Or(), "abc", ""},
+		{"t2", Or(In("a", "b")), "a", ""},
+		{"t3", Or(In("a", "b")), "c", "must satisfy one of: must be a valid value"},
+		{"t4", Or(In("a", "b"), Length(3, 3)), "abc", ""},
+		{"t5", Or(In("a", "b"), Length(3, 3)), "c", "must satisfy one of: must be a valid value; the length must be exactly 3"},
+		{"t6", Or(Length(3, 3), In("a", "b")), "c", "must satisfy one of: the length must be exactly 3; must be a valid value"},
+		{"t7", Or(In("a", "b"), Length(3, 3)), "", ""},
+		{"t8", Or(Required, NotNil), nil, "must satisfy one of: cannot be blank; is required"},
+		{"t9", Or(In("a"), And(Length(2, 2), In("xy"))), "xy", ""},
+		{"t10", Or(In("a"), And(Length(2, 2), In("xy"))), "xz", "must satisfy one of: must be a valid value; must be a valid value"},
+		{"t11", Or(In("a")).Error("must be a or b"), "c", "must be a or b"},
+		{"t12", Or(In("a")).Error("must be {{.errors}}!"), "c", "must be must be a valid value!"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Or(In("a"), Length(3, 3)).Validate("c")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_or", err.(Error).Code())
+		assert.Equal(t, "must be a valid value; the length must be exactly 3", err.(Error).Params()["errors"])
+	}
+	err = Or(In("a")).ErrorObject(NewError("abc", "def")).Validate("c")
+	assert.Equal(t, "abc", err.(Error).Code())
+}
+
+type orKey struct{}
+
+func TestOr_WithContext(t *testing.T) {
+	ctxRule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(orKey{}) == value {
+			return nil
+		}
+		return errors.New("must match the context")
+	})
+	ctx := context.WithValue(context.Background(), orKey{}, "c")
+	assert.Nil(t, Or(In("a"), ctxRule).ValidateWithContext(ctx, "c"))
+	assertError(t, "must satisfy one of: must be a valid value; must match the context", Or(In("a"), ctxRule).ValidateWithContext(ctx, "d"), "t1")
+	assert.Nil(t, ValidateWithContext(ctx, "c", Or(In("a"), ctxRule)))
+
+	internal := NewInternalError(errors.New("db down"))
+	err := Or(By(func(interface{}) error { return internal }), In("c")).Validate("c")
+	assert.Equal(t, internal, err)
+}
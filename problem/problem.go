@@ -0,0 +1,102 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package problem converts validation errors into RFC 7807 problem details (application/problem+json).
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ContentType is the media type of an RFC 7807 problem details JSON object.
+const ContentType = "application/problem+json"
+
+var (
+	// DefaultType is the problem type URI used by New.
+	DefaultType = "about:blank"
+	// DefaultTitle is the problem title used by New.
+	DefaultTitle = "Your request parameters didn't validate."
+	// DefaultStatus is the HTTP status code used by New.
+	DefaultStatus = http.StatusUnprocessableEntity
+)
+
+type (
+	// Details represents an RFC 7807 problem details object that describes validation errors.
+	Details struct {
+		Type   string       `json:"type"`
+		Title  string       `json:"title"`
+		Status int          `json:"status"`
+		Errors []FieldError `json:"errors"`
+	}
+
+	// FieldError describes the validation error of a single field.
+	FieldError struct {
+		// Field is the path of the invalid field, with path segments separated by dots (e.g. "address.zip").
+		Field string `json:"field"`
+		// Message is the error message.
+		Message string `json:"message"`
+		// Code is the error code, if the error provides one (e.g. "validation_required").
+		Code string `json:"code,omitempty"`
+		// Params holds the parameters of the rule that failed, if the error provides them (e.g. "min" and "max"
+		// of a Length rule), so that a client can render its own localized message.
+		Params map[string]interface{} `json:"params,omitempty"`
+	}
+
+	// walker is implemented by valid.Errors, valid.OrderedErrors and valid.KeyedErrors.
+	walker interface {
+		Walk(fn func(path []string, err error))
+	}
+
+	coder interface {
+		Code() string
+	}
+
+	paramer interface {
+		Params() map[string]interface{}
+	}
+)
+
+// New converts a validation error returned by the valid package into problem details.
+// Each leaf error of valid.Errors (or valid.OrderedErrors) becomes an entry in Details.Errors,
+// while any other error is reported as a single entry with an empty field.
+// The type, title and status are set to DefaultType, DefaultTitle and DefaultStatus, respectively.
+func New(err error) Details {
+	d := Details{
+		Type:   DefaultType,
+		Title:  DefaultTitle,
+		Status: DefaultStatus,
+		Errors: []FieldError{},
+	}
+	if err == nil {
+		return d
+	}
+	if w, ok := err.(walker); ok {
+		w.Walk(func(path []string, err error) {
+			d.Errors = append(d.Errors, newFieldError(strings.Join(path, "."), err))
+		})
+	} else {
+		d.Errors = append(d.Errors, newFieldError("", err))
+	}
+	return d
+}
+
+// Write writes the problem details to the given response writer as an application/problem+json response.
+func (d Details) Write(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(d.Status)
+	return json.NewEncoder(w).Encode(d)
+}
+
+func newFieldError(field string, err error) FieldError {
+	fe := FieldError{Field: field, Message: err.Error()}
+	if c, ok := err.(coder); ok {
+		fe.Code = c.Code()
+	}
+	if p, ok := err.(paramer); ok && len(p.Params()) > 0 {
+		fe.Params = p.Params()
+	}
+	return fe
+}
@@ -0,0 +1,70 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package problem
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	err := valid.Errors{
+		"name": valid.ErrRequired,
+		"address": valid.Errors{
+			"zip": valid.ErrMatchInvalid,
+		},
+		"tags": errors.New("too many tags"),
+	}
+
+	d := New(err)
+	assert.Equal(t, "about:blank", d.Type)
+	assert.Equal(t, DefaultTitle, d.Title)
+	assert.Equal(t, http.StatusUnprocessableEntity, d.Status)
+	assert.Equal(t, []FieldError{
+		{Field: "address.zip", Message: "must be in a valid format", Code: "validation_match_invalid"},
+		{Field: "name", Message: "cannot be blank", Code: "validation_required"},
+		{Field: "tags", Message: "too many tags"},
+	}, d.Errors)
+
+	d = New(errors.New("abc"))
+	assert.Equal(t, []FieldError{{Message: "abc"}}, d.Errors)
+
+	d = New(nil)
+	assert.Equal(t, []FieldError{}, d.Errors)
+}
+
+func TestDetails_Write(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := New(valid.Errors{"name": valid.ErrRequired}).Write(w)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Your request parameters didn't validate.","status":422,`+
+		`"errors":[{"field":"name","message":"cannot be blank","code":"validation_required"}]}`, w.Body.String())
+}
+
+func TestNew_Params(t *testing.T) {
+	err := valid.Errors{
+		"name": valid.Validate("abc", valid.Length(5, 50)),
+		"zip":  valid.ErrMatchInvalid,
+	}
+	d := New(err)
+	assert.Equal(t, []FieldError{
+		{Field: "name", Message: "the length must be between 5 and 50", Code: "validation_length_out_of_range",
+			Params: map[string]interface{}{"min": 5, "max": 50}},
+		{Field: "zip", Message: "must be in a valid format", Code: "validation_match_invalid"},
+	}, d.Errors)
+
+	w := httptest.NewRecorder()
+	assert.Nil(t, d.Write(w))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Your request parameters didn't validate.","status":422,"errors":[`+
+		`{"field":"name","message":"the length must be between 5 and 50","code":"validation_length_out_of_range","params":{"min":5,"max":50}},`+
+		`{"field":"zip","message":"must be in a valid format","code":"validation_match_invalid"}]}`, w.Body.String())
+}
@@ -0,0 +1,140 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import "fmt"
+
+// ErrOutOfRange is the error that returns when a value is not within the specified range.
+var ErrOutOfRange = NewError("validation_out_of_range", "must be between {{.min}} and {{.max}}")
+
+var (
+	// ErrOutOfRangeExclusive is the error that returns when a value is not within the specified exclusive range.
+	ErrOutOfRangeExclusive = NewError("validation_out_of_range_exclusive", "must be greater than {{.min}} and less than {{.max}}")
+	// ErrOutOfRangeExclusiveMin is the error that returns when a value is not within the specified range
+	// whose lower bound is exclusive.
+	ErrOutOfRangeExclusiveMin = NewError("validation_out_of_range_exclusive_min", "must be greater than {{.min}} and no greater than {{.max}}")
+	// ErrOutOfRangeExclusiveMax is the error that returns when a value is not within the specified range
+	// whose upper bound is exclusive.
+	ErrOutOfRangeExclusiveMax = NewError("validation_out_of_range_exclusive_max", "must be no less than {{.min}} and less than {{.max}}")
+)
+
+// Range returns a validation rule that checks if a value is within the specified inclusive range.
+// It combines Min(min) and Max(max) into a single rule with a single error message, and is the numeric
+// counterpart of Length. As with Min and Max, numbers of any int, uint and float types can be compared with
+// each other, and only number and time.Time types are supported.
+// Range panics if the bounds are of incomparable or unsupported types, or if min is greater than max.
+// An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
+func Range(min, max interface{}) RangeRule {
+	// check the bounds against each other, starting from the non-empty one since empty values are skipped
+	err := Max(max).Validate(min)
+	if IsEmpty(min) {
+		err = Min(min).Validate(max)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("valid: invalid range [%v, %v]: %v", min, max, err))
+	}
+	return RangeRule{
+		min: Min(min),
+		max: Max(max),
+		err: ErrOutOfRange.SetParams(map[string]interface{}{"min": min, "max": max}),
+	}
+}
+
+// RangeRule is a validation rule that checks if a value is within the specified range.
+type RangeRule struct {
+	min ThresholdRule
+	max ThresholdRule
+	err Error
+	// customErr and message record the error and the message set by ErrorObject and Error, which are kept
+	// when err is rebuilt for new bounds.
+	customErr Error
+	message   string
+}
+
+// ExclusiveMin configures the rule to require the value to be strictly greater than min.
+// Unless an error is set by ErrorObject, the error of the rule describes the new bounds.
+func (r RangeRule) ExclusiveMin() RangeRule {
+	r.min = r.min.Exclusive()
+	r.err = r.buildError()
+	return r
+}
+
+// ExclusiveMax configures the rule to require the value to be strictly less than max.
+// Unless an error is set by ErrorObject, the error of the rule describes the new bounds.
+func (r RangeRule) ExclusiveMax() RangeRule {
+	r.max = r.max.Exclusive()
+	r.err = r.buildError()
+	return r
+}
+
+// interval returns the bounds of the rule in the interval notation, e.g. "[1, 5)" if max is exclusive.
+func (r RangeRule) interval() string {
+	left, right := "[", "]"
+	if r.min.operator == greaterThan {
+		left = "("
+	}
+	if r.max.operator == lessThan {
+		right = ")"
+	}
+	return fmt.Sprintf("%v%v, %v%v", left, r.min.threshold, r.max.threshold, right)
+}
+
+// buildError returns the error set by ErrorObject, or the error describing the bounds of the rule, taking into account
+// whether they are exclusive, with the message set by Error.
+func (r RangeRule) buildError() Error {
+	if r.customErr != nil {
+		return r.customErr
+	}
+	err := r.boundsError()
+	if r.message != "" {
+		err = err.SetMessage(r.message)
+	}
+	return err
+}
+
+// boundsError returns the default error describing the bounds of the rule.
+func (r RangeRule) boundsError() Error {
+	exclusiveMin, exclusiveMax := r.min.operator == greaterThan, r.max.operator == lessThan
+	err := ErrOutOfRange
+	switch {
+	case exclusiveMin && exclusiveMax:
+		err = ErrOutOfRangeExclusive
+	case exclusiveMin:
+		err = ErrOutOfRangeExclusiveMin
+	case exclusiveMax:
+		err = ErrOutOfRangeExclusiveMax
+	}
+	return err.SetParams(map[string]interface{}{"min": r.min.threshold, "max": r.max.threshold})
+}
+
+// Validate checks if the given value is valid or not.
+func (r RangeRule) Validate(value interface{}) error {
+	err := r.min.Validate(value)
+	if err == nil {
+		err = r.max.Validate(value)
+	}
+	if _, ok := err.(Error); ok {
+		return r.err
+	}
+	return err
+}
+
+// Error sets the error message for the rule.
+func (r RangeRule) Error(message string) RangeRule {
+	if r.customErr != nil {
+		r.customErr = r.customErr.SetMessage(message)
+	} else {
+		r.message = message
+	}
+	r.err = r.buildError()
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r RangeRule) ErrorObject(err Error) RangeRule {
+	r.customErr, r.message = err, ""
+	r.err = r.buildError()
+	return r
+}
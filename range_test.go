@@ -0,0 +1,128 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	date0 := time.Time{}
+	date2000 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	date2001 := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	date2002 := time.Date(2002, 1, 1, 0, 0, 0, 0, time.UTC)
+	var v *int
+
+	tests := []struct {
+		tag   string
+		min   interface{}
+		max   interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1.1", 1, 100, 1, ""},
+		{"t1.2", 1, 100, 100, ""},
+		{"t1.3", 1, 100, 50, ""},
+		{"t1.4", 1, 100, 101, "must be between 1 and 100"},
+		{"t1.5", 1, 100, -1, "must be between 1 and 100"},
+		{"t1.6", 1, 100, 0, ""},
+		{"t1.7", 1, 100, v, ""},
+		{"t1.8", 1, 100, int8(5), ""},
+		{"t1.9", 1, 100, "abc", "cannot convert string to int64"},
+		{"t2.1", uint(1), uint(10), uint(10), ""},
+		{"t2.2", uint(1), uint(10), uint(11), "must be between 1 and 10"},
+		{"t3.1", 0.5, 1.5, 1.0, ""},
+		{"t3.2", 0.5, 1.5, 1.51, "must be between 0.5 and 1.5"},
+		{"t3.3", 0.0, 1.5, -0.1, "must be between 0 and 1.5"},
+		{"t4.1", date2000, date2002, date2001, ""},
+		{"t4.2", date2000, date2001, date2002, "must be between " + date2000.String() + " and " + date2001.String()},
+		{"t4.3", date2000, date2001, date0, ""},
+		{"t5.1", -10, 0, 5, "must be between -10 and 0"},
+		{"t5.2", 3, 3, 3, ""},
+	}
+
+	for _, test := range tests {
+		r := Range(test.min, test.max)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRange_InvalidBounds(t *testing.T) {
+	assert.Panics(t, func() { Range(10, 1) })
+	assert.Panics(t, func() { Range(0, -1) })
+	assert.Panics(t, func() { Range(10, 1.0) })
+	assert.Panics(t, func() { Range(0, -1.0) })
+	assert.Panics(t, func() { Range(1, time.Now()) })
+	assert.Panics(t, func() { Range("a", "b") })
+	assert.NotPanics(t, func() { Range(0, 0) })
+	assert.NotPanics(t, func() { Range(-1, 0) })
+	// numeric bounds of different types are compared as numbers
+	assert.Nil(t, Range(1, 10.5).Validate(int8(10)))
+	assert.Nil(t, Range(0, uint(10)).Validate(9.5))
+	// math/big bounds and values are compared exactly
+	huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+	assert.Nil(t, Range(0, huge).Validate(uint64(math.MaxUint64)))
+	assertError(t, "must be between 0 and 100000000000000000000", Range(0, huge).Validate(new(big.Int).Add(huge, huge)), "t1")
+	assert.Panics(t, func() { Range(huge, 10) })
+}
+
+func TestRangeRule_Exclusive(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  RangeRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Range(1, 10).ExclusiveMin(), 2, ""},
+		{"t1.2", Range(1, 10).ExclusiveMin(), 10, ""},
+		{"t1.3", Range(1, 10).ExclusiveMin(), 1, "must be greater than 1 and no greater than 10"},
+		{"t2.1", Range(1, 10).ExclusiveMax(), 1, ""},
+		{"t2.2", Range(1, 10).ExclusiveMax(), 10, "must be no less than 1 and less than 10"},
+		{"t3.1", Range(0.0, 1.0).ExclusiveMin().ExclusiveMax(), 0.5, ""},
+		{"t3.2", Range(0.0, 1.0).ExclusiveMin().ExclusiveMax(), 1.0, "must be greater than 0 and less than 1"},
+		{"t3.3", Range(-1.0, 1.0).ExclusiveMax().ExclusiveMin(), -1.0, "must be greater than -1 and less than 1"},
+		{"t4.1", Range(1, 10).ExclusiveMin(), "a", "cannot convert string to int64"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Range(1, 10).ExclusiveMin().Error("out of range").Validate(1)
+	assert.EqualError(t, err, "out of range")
+
+	// an error set before ExclusiveMin or ExclusiveMax is kept
+	r := Range(1, 10).Error("out of range").ExclusiveMin()
+	assert.EqualError(t, r.Validate(1), "out of range")
+	assert.Equal(t, "validation_out_of_range_exclusive_min", r.err.Code())
+	custom := NewError("code", "abc")
+	assert.Equal(t, custom, Range(1, 10).ErrorObject(custom).ExclusiveMax().Validate(10))
+}
+
+func TestRangeRule_Error(t *testing.T) {
+	r := Range(1, 10)
+	assert.Equal(t, "must be between 1 and 10", r.Validate(11).Error())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "123", r.Validate(11).Error())
+}
+
+func TestRangeRule_ErrorObject(t *testing.T) {
+	r := Range(1, 10)
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -4,9 +4,20 @@
 
 package valid
 
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
 var (
 	// ErrRequired is the error that returns when a value is required.
+	// It is used for strings and for the values whose type is unknown (e.g. a nil interface).
 	ErrRequired = NewError("validation_required", "cannot be blank")
+	// ErrRequiredValue is the error that returns when a number, a bool, a struct or any other scalar value is required.
+	ErrRequiredValue = NewError("validation_required", "is required")
+	// ErrRequiredElements is the error that returns when a slice, an array or a map is required.
+	ErrRequiredElements = NewError("validation_required", "cannot be empty")
 	// ErrNilOrNotEmpty is the error that returns when a value is not nil and is empty.
 	ErrNilOrNotEmpty = NewError("validation_nil_or_not_empty_required", "cannot be blank")
 )
@@ -17,7 +28,12 @@ var (
 // - bool: true
 // - string, array, slice, map: len() > 0
 // - interface, pointer: not nil and the referenced value is not empty
+// - channel, function: not nil (a channel with nothing queued in it is not empty)
 // - any other types
+//
+// The default error message depends on the kind of the value: "cannot be blank" for strings (ErrRequired),
+// "cannot be empty" for slices, arrays and maps (ErrRequiredElements) and "is required" for the others
+// (ErrRequiredValue). All of them share the same error code. Use Error() or ErrorObject() to override the message.
 var Required = RequiredRule{skipNil: false, condition: true}
 
 // NilOrNotEmpty checks if a value is a nil pointer or a value that is not empty.
@@ -26,34 +42,114 @@ var NilOrNotEmpty = RequiredRule{skipNil: true, condition: true}
 
 // RequiredRule is a rule that checks if a value is not empty.
 type RequiredRule struct {
-	condition bool
-	skipNil   bool
-	err       Error
+	condition        bool
+	contextCondition func(ctx context.Context) bool
+	skipNil          bool
+	trim             bool
+	err              Error
 }
 
 // Validate checks if the given value is valid or not.
 func (r RequiredRule) Validate(value interface{}) error {
 	if r.condition {
+		t := reflect.TypeOf(value)
 		value, isNil := Indirect(value)
+		if r.trim {
+			value = trimSpace(value)
+		}
 		if r.skipNil && !isNil && IsEmpty(value) || !r.skipNil && (isNil || IsEmpty(value)) {
-			if r.err != nil {
-				return r.err
-			}
-			if r.skipNil {
-				return ErrNilOrNotEmpty
-			}
-			return ErrRequired
+			return r.emptyError(requiredError(t))
 		}
 	}
 	return nil
 }
 
+// validateString checks a string without reflection. It returns the same result as Validate.
+func (r RequiredRule) validateString(value string) error {
+	if r.trim {
+		value = strings.TrimSpace(value)
+	}
+	if r.condition && value == "" {
+		return r.emptyError(ErrRequired)
+	}
+	return nil
+}
+
+// validateInt checks an int without reflection. It returns the same result as Validate.
+func (r RequiredRule) validateInt(value int) error {
+	if r.condition && value == 0 {
+		return r.emptyError(ErrRequiredValue)
+	}
+	return nil
+}
+
+// validateBool checks a bool without reflection. It returns the same result as Validate.
+func (r RequiredRule) validateBool(value bool) error {
+	if r.condition && !value {
+		return r.emptyError(ErrRequiredValue)
+	}
+	return nil
+}
+
+// emptyError returns the error of the rule for an empty value, whose default error is def.
+func (r RequiredRule) emptyError(def Error) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.skipNil {
+		return ErrNilOrNotEmpty
+	}
+	return def
+}
+
+// requiredError returns the default error of the Required rule for a value of the given type.
+func requiredError(t reflect.Type) Error {
+	if t == nil {
+		return ErrRequired
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Interface:
+		return ErrRequired
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return ErrRequiredElements
+	}
+	return ErrRequiredValue
+}
+
+// Trim configures the rule to consider a string or byte slice made of white space only (e.g. "   ") as empty,
+// by checking the value with the leading and trailing white space removed. By default, white space counts as
+// content, so "   " is not empty. Use it together with Length(...).Trim() to handle white space consistently.
+func (r RequiredRule) Trim() RequiredRule {
+	r.trim = true
+	return r
+}
+
 // When sets the condition that determines if the validation should be performed.
 func (r RequiredRule) When(condition bool) RequiredRule {
 	r.condition = condition
 	return r
 }
 
+// ValidateWithContext checks if the given value is valid or not using the condition set by WhenContext, if any.
+func (r RequiredRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if r.contextCondition != nil && !r.contextCondition(ctx) {
+		return nil
+	}
+	return r.Validate(value)
+}
+
+// WhenContext sets a function that determines from the validation context if the validation should be performed,
+// e.g. when the requiredness of a field depends on the current step of a multi-step form.
+// The function only takes effect when the validation is performed with a context (e.g. via ValidateWithContext).
+// Without a context, the rule is applied as if the function returned true.
+func (r RequiredRule) WhenContext(condition func(ctx context.Context) bool) RequiredRule {
+	r.contextCondition = condition
+	return r
+}
+
 // Error sets the error message for the rule.
 func (r RequiredRule) Error(message string) RequiredRule {
 	if r.err == nil {
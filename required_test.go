@@ -5,6 +5,8 @@
 package valid
 
 import (
+	"context"
+	"math/big"
 	"testing"
 	"time"
 
@@ -25,7 +27,15 @@ func TestRequired(t *testing.T) {
 		{"t3", &s1, ""},
 		{"t4", &s2, "cannot be blank"},
 		{"t5", nil, "cannot be blank"},
-		{"t6", time1, "cannot be blank"},
+		{"t6", time1, "is required"},
+		{"t7", 0, "is required"},
+		{"t8", false, "is required"},
+		{"t9", []int{}, "cannot be empty"},
+		{"t10", map[string]int{}, "cannot be empty"},
+		{"t11", (*int)(nil), "is required"},
+		{"t12", (*[]string)(nil), "cannot be empty"},
+		{"t13", MyString(""), "cannot be blank"},
+		{"t14", []byte{}, "cannot be empty"},
 	}
 
 	for _, test := range tests {
@@ -35,6 +45,75 @@ func TestRequired(t *testing.T) {
 	}
 }
 
+func TestRequired_Channel(t *testing.T) {
+	var nilChan chan int
+	assertError(t, "is required", Required.Validate(nilChan), "t1")
+	assertError(t, "is required", NotNil.Validate(nilChan), "t2")
+	// a non-nil channel is provided, even if nothing is queued in it
+	assert.Nil(t, Required.Validate(make(chan int)))
+	assert.Nil(t, Required.Validate(make(chan int, 1)))
+	assert.Nil(t, NotNil.Validate(make(chan int)))
+
+	s := struct{ Events chan string }{}
+	err := ValidateStruct(&s, Field(&s.Events, Required))
+	assertError(t, "Events: is required.", err, "t3")
+	s.Events = make(chan string)
+	assert.Nil(t, ValidateStruct(&s, Field(&s.Events, Required)))
+}
+
+func TestRequired_BigNumbers(t *testing.T) {
+	var nilInt *big.Int
+	assertError(t, "is required", Required.Validate(nilInt), "t1")
+	assertError(t, "is required", Required.Validate(new(big.Int)), "t2")
+	assertError(t, "is required", Required.Validate(new(big.Rat)), "t3")
+	assertError(t, "is required", Required.Validate(new(big.Float)), "t4")
+	assert.Nil(t, Required.Validate(big.NewInt(-1)))
+	assert.Nil(t, Required.Validate(big.NewRat(1, 3)))
+	assert.Nil(t, Required.Validate(big.NewFloat(0.1)))
+	assert.Nil(t, NotNil.Validate(new(big.Int)))
+}
+
+func TestRequiredRule_Trim(t *testing.T) {
+	blank := "   "
+	tests := []struct {
+		tag   string
+		rule  RequiredRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Required, blank, ""},
+		{"t1.2", Required.Trim(), blank, "cannot be blank"},
+		{"t1.3", Required.Trim(), &blank, "cannot be blank"},
+		{"t1.4", Required.Trim(), " \t\n", "cannot be blank"},
+		{"t1.5", Required.Trim(), MyString("  "), "cannot be blank"},
+		{"t1.6", Required.Trim(), []byte("  "), "cannot be empty"},
+		{"t1.7", Required.Trim(), " a ", ""},
+		{"t1.8", Required.Trim(), 0, "is required"},
+		{"t1.9", Required.Trim(), 1, ""},
+		{"t2.1", NilOrNotEmpty, blank, ""},
+		{"t2.2", NilOrNotEmpty.Trim(), blank, "cannot be blank"},
+		{"t2.3", NilOrNotEmpty.Trim(), (*string)(nil), ""},
+		{"t3.1", Required.Trim().Error("is blank"), blank, "is blank"},
+		{"t3.2", Required.Trim().When(false), blank, ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRequired_ErrorCode(t *testing.T) {
+	for _, value := range []interface{}{"", 0, []int{}} {
+		err := Required.Validate(value)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "validation_required", err.(Error).Code())
+		}
+	}
+	assert.EqualError(t, Required.Error("abc").Validate(0), "abc")
+	assert.EqualError(t, Required.Error("abc").Validate([]int{}), "abc")
+}
+
 func TestRequiredRule_When(t *testing.T) {
 	r := Required.When(false)
 	err := Validate(nil, r)
@@ -45,6 +124,30 @@ func TestRequiredRule_When(t *testing.T) {
 	assert.Equal(t, ErrRequired, err)
 }
 
+func TestRequiredRule_WhenContext(t *testing.T) {
+	k := key(2)
+	r := Required.WhenContext(func(ctx context.Context) bool {
+		return ctx.Value(k) == "step2"
+	})
+	ctx1 := context.WithValue(context.Background(), k, "step1")
+	ctx2 := context.WithValue(context.Background(), k, "step2")
+
+	assert.Nil(t, ValidateWithContext(ctx1, "", r))
+	assert.Equal(t, ErrRequired, ValidateWithContext(ctx2, "", r))
+	assert.Nil(t, ValidateWithContext(ctx2, "abc", r))
+	// without a context the value is always required
+	assert.Equal(t, ErrRequired, Validate("", r))
+	// the static condition still applies
+	assert.Nil(t, ValidateWithContext(ctx2, "", r.When(false)))
+	assert.Equal(t, ErrNilOrNotEmpty, ValidateWithContext(ctx2, "", NilOrNotEmpty.WhenContext(func(context.Context) bool { return true })))
+
+	m := struct{ Name string }{}
+	err := ValidateStructWithContext(ctx1, &m, Field(&m.Name, r))
+	assert.Nil(t, err)
+	err = ValidateStructWithContext(ctx2, &m, Field(&m.Name, r))
+	assertError(t, "Name: cannot be blank.", err, "t1")
+}
+
 func TestNilOrNotEmpty(t *testing.T) {
 	s1 := "123"
 	s2 := ""
@@ -98,3 +201,39 @@ func TestRequiredRule_Error(t *testing.T) {
 	assert.Equal(t, err.Message(), r.err.Message())
 	assert.NotEqual(t, err, Required.err)
 }
+
+func TestRequired_ZeroTime(t *testing.T) {
+	var zero time.Time
+	now := time.Now()
+	assertError(t, "is required", Required.Validate(zero), "t1")
+	assertError(t, "is required", Required.Validate(&zero), "t2")
+	assertError(t, "is required", Required.Validate(zero.In(time.FixedZone("UTC+1", 3600))), "t3")
+	assert.Nil(t, Required.Validate(now))
+	assert.Nil(t, NilOrNotEmpty.Validate((*time.Time)(nil)))
+	assertError(t, "cannot be blank", NilOrNotEmpty.Validate(&zero), "t4")
+	assert.Nil(t, NilOrNotEmpty.Validate(&now))
+	assertError(t, "must be blank", Empty.Validate(now), "t5")
+	assert.Nil(t, Empty.Validate(zero))
+
+	// an optional time field composes with the bounds, which skip the zero value
+	type event struct {
+		StartsAt time.Time
+		EndsAt   *time.Time
+	}
+	e := event{EndsAt: &zero}
+	rules := func(e *event) []*FieldRules {
+		return []*FieldRules{
+			Field(&e.StartsAt, Max(now)),
+			Field(&e.EndsAt, Min(now), Max(now.AddDate(1, 0, 0))),
+		}
+	}
+	assert.Nil(t, ValidateStruct(&e, rules(&e)...))
+	err := ValidateStruct(&e, Field(&e.StartsAt, Required, Max(now)), Field(&e.EndsAt, Required))
+	assertError(t, "EndsAt: is required; StartsAt: is required.", err, "t6")
+	later := now.AddDate(2, 0, 0)
+	e = event{StartsAt: later, EndsAt: &later}
+	err = ValidateStruct(&e, rules(&e)...)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "EndsAt: must be no greater than")
+	assert.Contains(t, err.Error(), "StartsAt: must be no greater than")
+}
@@ -0,0 +1,118 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// RuleError wraps the error returned by a failed rule with metadata about the failure, which is useful for
+// structured logging. ValidateWithContext returns RuleError only if the context is prepared by WithRuleErrors().
+// Error() returns the message of the wrapped error, so a RuleError reads the same as the error it wraps.
+type RuleError struct {
+	err      error
+	field    string
+	value    interface{}
+	ruleName string
+}
+
+type ruleErrorsKey struct{}
+
+// ruleErrorOptions holds the options passed to WithRuleErrors.
+type ruleErrorOptions struct {
+	includeValue bool
+}
+
+// WithRuleErrors returns a copy of the context that makes ValidateWithContext wrap the error of a failed rule
+// into a RuleError. Because the value may contain sensitive data, it is only recorded in the RuleError if
+// includeValue is true. Errors that describe many values (Errors, OrderedErrors, KeyedErrors, FieldErrors) and internal errors are never wrapped.
+func WithRuleErrors(ctx context.Context, includeValue bool) context.Context {
+	return context.WithValue(ctx, ruleErrorsKey{}, ruleErrorOptions{includeValue: includeValue})
+}
+
+// Error returns the error message of the wrapped error.
+func (e RuleError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error returned by the rule.
+func (e RuleError) Unwrap() error {
+	return e.err
+}
+
+// Code returns the error code of the wrapped error if it implements Error. Otherwise, an empty string is returned.
+func (e RuleError) Code() string {
+	if ve, ok := e.err.(Error); ok {
+		return ve.Code()
+	}
+	return ""
+}
+
+// Params returns the parameters of the wrapped error if it implements Error. Otherwise, nil is returned.
+func (e RuleError) Params() map[string]interface{} {
+	if ve, ok := e.err.(Error); ok {
+		return ve.Params()
+	}
+	return nil
+}
+
+// Field returns the name of the struct field or map key being validated.
+// It is empty if the value is not validated as a part of a struct or a map.
+func (e RuleError) Field() string {
+	return e.field
+}
+
+// Value returns the value that failed the rule. It is nil unless WithRuleErrors() was called with includeValue set.
+func (e RuleError) Value() interface{} {
+	return e.value
+}
+
+// RuleName returns the name of the rule that failed, which is its type name (e.g. "valid.ThresholdRule").
+func (e RuleError) RuleName() string {
+	return e.ruleName
+}
+
+// wrapRuleError wraps the error returned by the given rule into a RuleError if requested by the context.
+func wrapRuleError(ctx context.Context, rule Rule, value interface{}, err error) error {
+	if ctx == nil {
+		return err
+	}
+	opts, ok := ctx.Value(ruleErrorsKey{}).(ruleErrorOptions)
+	if !ok {
+		return err
+	}
+	switch e := err.(type) {
+	case Errors, OrderedErrors, KeyedErrors, FieldErrors, RuleError:
+		return err
+	case InternalError:
+		if e.InternalError() != nil {
+			return err
+		}
+	case abortError:
+		return abortError{wrapRuleError(ctx, rule, value, e.error)}
+	}
+	re := RuleError{
+		err:      err,
+		ruleName: strings.TrimPrefix(fmt.Sprintf("%T", rule), "*"),
+	}
+	if opts.includeValue {
+		re.value = value
+	}
+	return re
+}
+
+// withErrorField records the field name in the given error if it is a RuleError.
+func withErrorField(err error, field string) error {
+	switch e := err.(type) {
+	case RuleError:
+		e.field = field
+		return e
+	case abortError:
+		return abortError{withErrorField(e.error, field)}
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRuleErrors(t *testing.T) {
+	// without WithRuleErrors, the rule error is returned as is
+	err := ValidateWithContext(context.Background(), 1, Min(2))
+	assert.Equal(t, ErrMinGreaterEqualThanRequired.SetParams(map[string]interface{}{"threshold": 2}), err)
+
+	ctx := WithRuleErrors(context.Background(), false)
+	err = ValidateWithContext(ctx, 1, Required, Min(2))
+	if re, ok := err.(RuleError); assert.True(t, ok) {
+		assert.Equal(t, "must be no less than 2", re.Error())
+		assert.Equal(t, "validation_min_greater_equal_than_required", re.Code())
+		assert.Equal(t, "valid.ThresholdRule", re.RuleName())
+		assert.Equal(t, "", re.Field())
+		assert.Nil(t, re.Value())
+		assert.Equal(t, ErrMinGreaterEqualThanRequired.Code(), errors.Unwrap(err).(Error).Code())
+	}
+
+	ctx = WithRuleErrors(context.Background(), true)
+	err = ValidateWithContext(ctx, "abc", By(func(interface{}) error { return errors.New("xyz") }))
+	if re, ok := err.(RuleError); assert.True(t, ok) {
+		assert.Equal(t, "xyz", re.Error())
+		assert.Equal(t, "", re.Code())
+		assert.Equal(t, "valid.inlineRule", re.RuleName())
+		assert.Equal(t, "abc", re.Value())
+	}
+
+	// errors of many values and internal errors are not wrapped
+	err = ValidateWithContext(ctx, []int{1}, Each(Min(2)))
+	_, ok := err.(Errors)
+	assert.True(t, ok)
+	err = ValidateWithContext(ctx, 1, By(func(interface{}) error { return NewInternalError(errors.New("abc")) }))
+	_, ok = err.(InternalError)
+	assert.True(t, ok)
+
+	assert.Nil(t, ValidateWithContext(ctx, 3, Min(2)))
+}
+
+func TestWithRuleErrors_Struct(t *testing.T) {
+	m := Model1{A: "abc", B: "xyz"}
+	ctx := WithRuleErrors(context.Background(), true)
+	err := ValidateStructWithContext(ctx, &m,
+		Field(&m.A, Length(5, 10)),
+		Field(&m.B, By(func(interface{}) error { return Abort(errors.New("bad")) })),
+		Field(&m.c, Required),
+	)
+	errs, ok := err.(Errors)
+	if assert.True(t, ok) {
+		assert.Equal(t, "A: the length must be between 5 and 10; B: bad.", errs.Error())
+		re := errs["A"].(RuleError)
+		assert.Equal(t, "A", re.Field())
+		assert.Equal(t, "abc", re.Value())
+		assert.Equal(t, "valid.LengthRule", re.RuleName())
+		assert.Equal(t, "B", errs["B"].(RuleError).Field())
+	}
+
+	err = ValidateWithContext(ctx, map[string]interface{}{"A": 1}, Map(Key("A", Min(2))))
+	if errs, ok := err.(Errors); assert.True(t, ok) {
+		assert.Equal(t, "A", errs["A"].(RuleError).Field())
+	}
+
+	// a struct validated without a context is not affected
+	err = ValidateStruct(&m, Field(&m.A, Length(5, 10)))
+	_, ok = err.(Errors)["A"].(RuleError)
+	assert.False(t, ok)
+}
+
+func TestRuleError_Params(t *testing.T) {
+	ctx := WithRuleErrors(context.Background(), false)
+	err := ValidateWithContext(ctx, "abc", Length(5, 50))
+	if assert.IsType(t, RuleError{}, err) {
+		assert.Equal(t, map[string]interface{}{"min": 5, "max": 50}, err.(RuleError).Params())
+	}
+	err = ValidateWithContext(ctx, "abc", By(func(interface{}) error { return errors.New("abc") }))
+	if assert.IsType(t, RuleError{}, err) {
+		assert.Nil(t, err.(RuleError).Params())
+	}
+}
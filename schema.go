@@ -0,0 +1,217 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrSchemaStruct is the error that the schema given to Schema is not a struct or a pointer to a struct.
+	ErrSchemaStruct = errors.New("the schema must be a struct or a pointer to a struct")
+
+	// ErrSchemaType is the error returned when the value of a map key does not have the type of the schema field.
+	ErrSchemaType = NewError("validation_schema_type", "must be of type {{.type}}")
+
+	// ErrSchemaValue is the error returned when the value of a map key cannot be decoded into the schema field
+	// for a reason other than its type (e.g. a malformed time).
+	ErrSchemaValue = NewError("validation_schema_value", "must be a valid value")
+)
+
+// Schema returns a validation rule that checks a map with string keys (typically a request body decoded from JSON
+// into a map[string]interface{}) against a schema defined as a struct, so that a typed definition can be reused
+// to validate loosely-typed input. The schema is only used for its type and may be given as a struct or a pointer
+// to one, e.g. Schema(CreateUserRequest{}).
+//
+// The keys of the map are mapped to the fields of the schema like encoding/json does, i.e. using the names given
+// by the json struct tags. The value of each key is decoded into a new instance of the schema struct, and a value
+// that cannot be decoded is reported for its key, e.g. "age: must be of type int". The decoded struct is then
+// validated like Validate does, so the rules of the schema are given by its Validate() method:
+//
+//	func (r CreateUserRequest) Validate() error {
+//	    return valid.ValidateStruct(&r,
+//	        valid.Field(&r.Name, valid.Required),
+//	        valid.Field(&r.Age, valid.Min(18)),
+//	    )
+//	}
+//
+//	err := valid.Validate(body, valid.Schema(CreateUserRequest{}))
+//
+// The errors are returned as Errors keyed by the map keys, with the errors of nested fields nested accordingly,
+// whatever the type of the errors returned by Validate() (e.g. the FieldErrors of a struct validated with AsSlice,
+// whose paths such as "address.street" are split at the dots). The errors of the keys that cannot be decoded take
+// precedence over the errors of the same fields returned by Validate(). An error of Validate() that is not keyed by
+// fields (e.g. one checking several fields together) is returned as is if no key has an error, and is left out
+// otherwise, as it is found on a struct that could not be fully decoded.
+// A key that does not match any field is reported with ErrKeyUnexpected, unless AllowUnknownKeys is
+// called. A nil map is considered valid. Use the Required rule to make sure a map is present.
+func Schema(schema interface{}) SchemaRule {
+	t := reflect.TypeOf(schema)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t != nil && t.Kind() != reflect.Struct {
+		t = nil
+	}
+	return SchemaRule{typ: t}
+}
+
+// ValidateMapWithSchema validates a map against a schema defined as a struct.
+// It is a shortcut for Validate(m, Schema(schema)). Please refer to Schema for more details.
+func ValidateMapWithSchema(m map[string]interface{}, schema interface{}) error {
+	return Schema(schema).Validate(m)
+}
+
+// SchemaRule is a validation rule that checks a map against a schema defined as a struct.
+type SchemaRule struct {
+	typ              reflect.Type
+	allowUnknownKeys bool
+}
+
+// AllowUnknownKeys configures the rule to ignore the keys that do not match any field of the schema.
+func (r SchemaRule) AllowUnknownKeys() SchemaRule {
+	r.allowUnknownKeys = true
+	return r
+}
+
+// Validate checks if the given map is valid against the schema.
+func (r SchemaRule) Validate(m interface{}) error {
+	return r.ValidateWithContext(nil, m)
+}
+
+// ValidateWithContext checks if the given map is valid against the schema using the given context.
+func (r SchemaRule) ValidateWithContext(ctx context.Context, m interface{}) error {
+	if r.typ == nil {
+		return NewInternalError(ErrSchemaStruct)
+	}
+	value := reflect.ValueOf(m)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return NewInternalError(ErrNotMap)
+	}
+	if value.IsNil() {
+		return nil
+	}
+
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	errs := Errors{}
+	ptr := reflect.New(r.typ)
+	for _, k := range keys {
+		key := k.String()
+		data, err := json.Marshal(map[string]interface{}{key: value.MapIndex(k).Interface()})
+		if err != nil {
+			return NewInternalError(err)
+		}
+		if err = json.Unmarshal(data, ptr.Interface()); err != nil {
+			errs[key] = decodeError(key, err)
+		} else if !r.allowUnknownKeys && !knownKey(r.typ, data) {
+			errs[key] = ErrKeyUnexpected
+		}
+	}
+
+	var err error
+	if ctx == nil {
+		err = Validate(ptr.Interface())
+	} else {
+		err = ValidateWithContext(ctx, ptr.Interface())
+	}
+	if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+		return err
+	}
+	if !mergeSchemaErrors(errs, err) && len(errs) == 0 {
+		// an error that is not keyed by fields is only returned if the keys have no error,
+		// as the struct is not fully decoded otherwise
+		return err
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// mergeSchemaErrors adds the leaf errors of the validation of the decoded struct to the errors of the keys,
+// nesting them by their paths. The field paths of FieldErrors are split at the dots. A key that already has an error
+// keeps it. It returns false if err is not nil and is not keyed by fields, in which case nothing is added.
+func mergeSchemaErrors(errs Errors, err error) bool {
+	switch e := err.(type) {
+	case nil:
+	case FieldErrors:
+		for _, fe := range e {
+			addSchemaError(errs, strings.Split(fe.Field, "."), fe.Err)
+		}
+	case interface {
+		Walk(fn func(path []string, err error))
+	}:
+		e.Walk(func(path []string, err error) {
+			addSchemaError(errs, path, err)
+		})
+	default:
+		return false
+	}
+	return true
+}
+
+// addSchemaError adds the error under the given path of keys unless one of them already has an error.
+func addSchemaError(errs Errors, path []string, err error) {
+	for _, key := range path[:len(path)-1] {
+		e, ok := errs[key]
+		if !ok {
+			e = Errors{}
+			errs[key] = e
+		}
+		nested, ok := e.(Errors)
+		if !ok {
+			return
+		}
+		errs = nested
+	}
+	if _, ok := errs[path[len(path)-1]]; !ok {
+		errs[path[len(path)-1]] = err
+	}
+}
+
+// knownKey reports whether the single key of the given JSON object matches a field of the struct type.
+func knownKey(t reflect.Type, data []byte) bool {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(reflect.New(t).Interface()) == nil
+}
+
+// decodeError converts the error of decoding the value of the given key into a validation error.
+// The error of a nested field is nested accordingly, e.g. Errors{"zip": ...} for the key "address".
+func decodeError(key string, err error) error {
+	te, ok := err.(*json.UnmarshalTypeError)
+	if !ok {
+		return ErrSchemaValue
+	}
+	var result error = ErrSchemaType.SetParams(map[string]interface{}{"type": te.Type.String()})
+	path := strings.Split(te.Field, ".")
+	for i, name := range path {
+		if strings.EqualFold(name, key) {
+			path = path[i+1:]
+			break
+		}
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] != "" {
+			result = Errors{path[i]: result}
+		}
+	}
+	return result
+}
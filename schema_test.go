@@ -0,0 +1,196 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type schemaAddress struct {
+	Street string `json:"street"`
+	Zip    int    `json:"zip"`
+}
+
+type schemaUser struct {
+	Name    string        `json:"name"`
+	Age     int           `json:"age"`
+	Tags    []string      `json:"tags"`
+	Address schemaAddress `json:"address"`
+	Born    time.Time     `json:"born"`
+}
+
+func (u schemaUser) Validate() error {
+	return ValidateStruct(&u,
+		Field(&u.Name, Required, Length(2, 10)),
+		Field(&u.Age, Min(18)),
+		Field(&u.Tags, Each(Required)),
+	)
+}
+
+type schemaKey struct{}
+
+type schemaContextUser struct {
+	Name string `json:"name"`
+}
+
+func (u *schemaContextUser) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, u,
+		Field(&u.Name, WithContext(func(ctx context.Context, value interface{}) error {
+			if ctx.Value(schemaKey{}) == value {
+				return nil
+			}
+			return errors.New("unexpected name")
+		})),
+	)
+}
+
+type schemaInternalUser struct {
+	Name string `json:"name"`
+}
+
+func (u schemaInternalUser) Validate() error {
+	return NewInternalError(errors.New("internal"))
+}
+
+type schemaSliceUser struct {
+	Name    string        `json:"name"`
+	Age     int           `json:"age"`
+	Address schemaAddress `json:"address"`
+}
+
+func (u schemaSliceUser) Validate() error {
+	return Struct(&u,
+		Field(&u.Name, Required),
+		Field(&u.Address, By(func(interface{}) error {
+			return ValidateStruct(&u.Address, Field(&u.Address.Street, Required))
+		})),
+	).AsSlice().Validate()
+}
+
+type schemaKeyedUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func (u schemaKeyedUser) Validate() error {
+	return Validate(map[int]string{1: u.Name}, Each(Required))
+}
+
+type schemaPlainUser struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func (u schemaPlainUser) Validate() error {
+	if u.Name == "" {
+		return errors.New("name missing")
+	}
+	return nil
+}
+
+func TestSchema(t *testing.T) {
+	var m0 map[string]interface{}
+	tests := []struct {
+		tag   string
+		rule  SchemaRule
+		value interface{}
+		err   string
+	}{
+		{"t1.1", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20}, ""},
+		{"t1.2", Schema(&schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "tags": []string{"a"}}, ""},
+		{"t1.3", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "address": map[string]interface{}{"street": "Main", "zip": 12345}}, ""},
+		{"t1.4", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "born": "2000-01-02T00:00:00Z"}, ""},
+		// rules of the schema
+		{"t2.1", Schema(schemaUser{}), map[string]interface{}{"age": 20}, "name: cannot be blank."},
+		{"t2.2", Schema(schemaUser{}), map[string]interface{}{"name": "J", "age": 10}, "age: must be no less than 18; name: the length must be between 2 and 10."},
+		{"t2.3", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "tags": []string{"a", ""}}, "tags: (1: cannot be blank.)."},
+		// values of the wrong type
+		{"t3.1", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": "20"}, "age: must be of type int."},
+		{"t3.2", Schema(schemaUser{}), map[string]interface{}{"name": 123, "age": 20}, "name: must be of type string."},
+		{"t3.3", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "address": map[string]interface{}{"zip": "x"}}, "address: (zip: must be of type int.)."},
+		{"t3.4", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "address": "Main"}, "address: must be of type valid.schemaAddress."},
+		{"t3.5", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "born": "yesterday"}, "born: must be a valid value."},
+		{"t3.6", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 1.5}, "age: must be of type int."},
+		// decode errors take precedence over the rules of the schema
+		{"t3.7", Schema(schemaUser{}), map[string]interface{}{"age": "20"}, "age: must be of type int; name: cannot be blank."},
+		// errors of the schema of any type are merged with the decode errors
+		{"t3.8", Schema(schemaSliceUser{}), map[string]interface{}{"age": "20"}, "address: (street: cannot be blank.); age: must be of type int; name: cannot be blank."},
+		{"t3.9", Schema(schemaSliceUser{}), map[string]interface{}{"address": map[string]interface{}{"zip": "x"}}, "address: (street: cannot be blank; zip: must be of type int.); name: cannot be blank."},
+		{"t3.10", Schema(schemaSliceUser{}), map[string]interface{}{"name": "John"}, "address: (street: cannot be blank.)."},
+		{"t3.11", Schema(schemaKeyedUser{}), map[string]interface{}{"age": "20"}, "1: cannot be blank; age: must be of type int."},
+		{"t3.12", Schema(schemaPlainUser{}), map[string]interface{}{"age": "20"}, "age: must be of type int."},
+		{"t3.13", Schema(schemaPlainUser{}), map[string]interface{}{"age": 20}, "name missing"},
+		// unknown keys
+		{"t4.1", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "email": "a@b.c"}, "email: key not expected."},
+		{"t4.2", Schema(schemaUser{}).AllowUnknownKeys(), map[string]interface{}{"name": "John", "age": 20, "email": "a@b.c"}, ""},
+		{"t4.3", Schema(schemaUser{}), map[string]interface{}{"name": "John", "age": 20, "address": map[string]interface{}{"city": "x"}}, "address: key not expected."},
+		// nil and non-map values
+		{"t5.1", Schema(schemaUser{}), m0, ""},
+		{"t5.2", Schema(schemaUser{}), &m0, ""},
+		{"t5.3", Schema(schemaUser{}), (*map[string]interface{})(nil), ""},
+		{"t5.4", Schema(schemaUser{}), nil, ErrNotMap.Error()},
+		{"t5.5", Schema(schemaUser{}), 123, ErrNotMap.Error()},
+		{"t5.6", Schema(schemaUser{}), map[int]interface{}{1: "a"}, ErrNotMap.Error()},
+		{"t5.7", Schema(schemaUser{}), map[string]string{"name": "J"}, "name: the length must be between 2 and 10."},
+		// invalid schema
+		{"t6.1", Schema(123), map[string]interface{}{}, ErrSchemaStruct.Error()},
+		{"t6.2", Schema(nil), map[string]interface{}{}, ErrSchemaStruct.Error()},
+		{"t6.3", Schema(schemaInternalUser{}), map[string]interface{}{"name": "John"}, "internal"},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Schema(123).Validate(map[string]interface{}{})
+	_, ok := err.(InternalError)
+	assert.True(t, ok)
+	err = Schema(schemaUser{}).Validate(map[string]interface{}{"name": func() {}})
+	_, ok = err.(InternalError)
+	assert.True(t, ok)
+}
+
+func TestSchema_Errors(t *testing.T) {
+	// the errors have the same type whether or not a key fails to be decoded
+	for _, m := range []map[string]interface{}{{"name": "John"}, {"name": "John", "age": "20"}} {
+		err := Schema(schemaSliceUser{}).Validate(m)
+		if es, ok := err.(Errors); assert.True(t, ok, "%v", m) {
+			assert.Equal(t, Errors{"street": ErrRequired}, es["address"], "%v", m)
+		}
+	}
+	err := Schema(schemaSliceUser{}).Validate(map[string]interface{}{"age": "20"})
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.Len(t, es, 3)
+		assert.Equal(t, ErrRequired, es["name"])
+		assert.IsType(t, ErrorObject{}, es["age"])
+		assert.IsType(t, Errors{}, es["address"])
+	}
+
+	// an error that is not keyed by fields is not reported under a key
+	err = Schema(schemaPlainUser{}).Validate(map[string]interface{}{"age": "20", "email": "a@b.c"})
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.Len(t, es, 2)
+		assert.NotContains(t, es, "")
+	}
+}
+
+func TestSchema_WithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), schemaKey{}, "John")
+	rule := Schema(schemaContextUser{})
+	assert.Nil(t, rule.ValidateWithContext(ctx, map[string]interface{}{"name": "John"}))
+	assertError(t, "name: unexpected name.", rule.ValidateWithContext(ctx, map[string]interface{}{"name": "Jane"}), "t1")
+	assertError(t, "name: must be of type string.", ValidateWithContext(ctx, map[string]interface{}{"name": 1}, rule), "t2")
+}
+
+func TestValidateMapWithSchema(t *testing.T) {
+	assert.Nil(t, ValidateMapWithSchema(map[string]interface{}{"name": "John", "age": 20}, schemaUser{}))
+	assertError(t, "age: must be of type int.", ValidateMapWithSchema(map[string]interface{}{"name": "John", "age": "20"}, schemaUser{}), "t1")
+	assertError(t, "name: cannot be blank.", Validate(map[string]interface{}{"age": 20}, Schema(schemaUser{})), "t2")
+}
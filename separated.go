@@ -0,0 +1,72 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Separated returns a validation rule that splits a string by the given separator and validates each part,
+// with the surrounding white space trimmed, using the provided rules. This is useful for the fields that hold
+// a delimited list in a single string, e.g. a comma-separated list of email addresses:
+//
+//	valid.Field(&m.CC, valid.Separated(",", is.EmailFormat))
+//
+// The errors are keyed by the position of the part in the list, like those of Each.
+// Note that an empty part (e.g. in "a@example.com,,b@example.com") is validated as an empty string, so it is
+// only reported by rules that reject empty values, such as Required.
+// This rule should only be used for validating strings and byte slices, or a validation error will be reported.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Separated(sep string, rules ...Rule) SeparatedRule {
+	return SeparatedRule{
+		sep:   sep,
+		rules: rules,
+	}
+}
+
+// SeparatedRule is a validation rule that validates each part of a delimited string using the specified list of rules.
+type SeparatedRule struct {
+	sep   string
+	rules []Rule
+}
+
+// Validate splits the given string and validates each part with the rules.
+func (r SeparatedRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext splits the given string and validates each part with the rules using the given context.
+func (r SeparatedRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	errs := Errors{}
+	for i, part := range strings.Split(str, r.sep) {
+		part = strings.TrimSpace(part)
+		if ctx == nil {
+			err = Validate(part, r.rules...)
+		} else {
+			err = ValidateWithContext(context.WithValue(ctx, eachIndexKey{}, i), part, r.rules...)
+		}
+		if err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			errs[strconv.Itoa(i)] = err
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
@@ -0,0 +1,68 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeparated(t *testing.T) {
+	var s *string
+	empty := ""
+	list := "a, b"
+
+	tests := []struct {
+		tag   string
+		sep   string
+		value interface{}
+		err   string
+	}{
+		{"t1", ",", nil, ""},
+		{"t2", ",", s, ""},
+		{"t3", ",", &empty, ""},
+		{"t4", ",", "", ""},
+		{"t5", ",", "abc", ""},
+		{"t6", ",", "abc, de , fgh", ""},
+		{"t7", ",", "abc,,fgh", "1: cannot be blank."},
+		{"t8", ",", "a, bcd, e", "0: the length must be between 2 and 3; 2: the length must be between 2 and 3."},
+		{"t9", ";", "ab;cd", ""},
+		{"t10", ";", "ab,cd", "0: the length must be between 2 and 3."},
+		{"t11", ",", &list, "0: the length must be between 2 and 3; 1: the length must be between 2 and 3."},
+		{"t12", ",", []byte("ab, c"), "1: the length must be between 2 and 3."},
+		{"t13", ",", 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		r := Separated(test.sep, Required, Length(2, 3))
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSeparated_WithContext(t *testing.T) {
+	var indexes []interface{}
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		index, _ := IndexFromContext(ctx)
+		indexes = append(indexes, index)
+		if value == "x" {
+			return errors.New("must not be x")
+		}
+		return nil
+	})
+	err := Separated("|", rule).ValidateWithContext(context.Background(), "a|x|b")
+	assertError(t, "1: must not be x.", err, "t1")
+	assert.Equal(t, []interface{}{0, 1, 2}, indexes)
+
+	err = Separated("|", rule).ValidateWithContext(context.Background(), "a")
+	assert.Nil(t, err)
+
+	internal := NewInternalError(errors.New("db down"))
+	err = Separated(",", By(func(interface{}) error { return internal })).Validate("a,b")
+	assert.Equal(t, internal, err)
+}
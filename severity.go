@@ -0,0 +1,210 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+)
+
+// Severity represents how serious a validation error is.
+type Severity int
+
+const (
+	// SeverityError is the severity of a validation error that makes the data invalid. It is the default severity.
+	SeverityError Severity = iota
+	// SeverityWarning is the severity of a validation error that is reported to the user but does not
+	// make the data invalid.
+	SeverityWarning
+)
+
+// String returns the name of the severity, i.e. "error" or "warning".
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// warningError marks a validation error as a warning.
+type warningError struct {
+	error
+}
+
+// Severity returns SeverityWarning.
+func (e warningError) Severity() Severity {
+	return SeverityWarning
+}
+
+// Unwrap returns the validation error that it wraps around.
+func (e warningError) Unwrap() error {
+	return e.error
+}
+
+// SeverityOf returns the severity of a validation error. An error that implements (or wraps an error that implements)
+// a `Severity() Severity` method has the severity returned by the method, e.g. the errors of the rules wrapped with
+// Warn. All other errors have SeverityError.
+func SeverityOf(err error) Severity {
+	var s interface{ Severity() Severity }
+	if errors.As(err, &s) {
+		return s.Severity()
+	}
+	return SeverityError
+}
+
+// Warn returns a validation rule that validates a value with the given rules and reports their errors as warnings.
+// For example, a password that is valid but weak can be flagged without rejecting it:
+//
+//	valid.Field(&u.Password, valid.Required, valid.Warn(valid.Length(12, 0).Error("should be at least 12 characters")))
+//
+// If the rules return nested errors (e.g. Each), every leaf error is marked as a warning.
+//
+// A warning does not make the validation fail: unless warnings are requested, they are dropped from the result,
+// so that Validate returns nil for a value that only has warnings. They are requested by validating with a context
+// returned by WithWarnings, or by a struct validator configured with CollectWarnings, which reports them separately
+// from the errors. A warning does not stop the validation either: the rules after Warn are still applied, and the
+// value is still validated as a Validatable. Their error is returned instead of the warning if they fail.
+func Warn(rules ...Rule) Rule {
+	return warnRule{rules: rules}
+}
+
+type warnRule struct {
+	rules []Rule
+}
+
+// Validate validates the value with the rules. As no warnings can be requested without a context, only internal
+// errors are returned.
+func (r warnRule) Validate(value interface{}) error {
+	return reportWarnings(nil, markWarnings(Validate(value, r.rules...)))
+}
+
+// ValidateWithContext validates the value with the rules using the context and marks their errors as warnings,
+// which are returned if they are requested by the context.
+func (r warnRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	return reportWarnings(ctx, markWarnings(ValidateWithContext(ctx, value, r.rules...)))
+}
+
+type warningsKey struct{}
+
+// WithWarnings returns a copy of ctx under which the warnings (see Warn) are returned together with the errors by
+// ValidateWithContext and the other functions validating with a context. Use SplitWarnings to separate them:
+//
+//	err, warnings := valid.SplitWarnings(valid.ValidateWithContext(valid.WithWarnings(ctx), value, rules...))
+func WithWarnings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey{}, true)
+}
+
+// reportWarnings returns err if the warnings are requested by ctx (see WithWarnings), or the errors in err that are
+// not warnings otherwise. A nil ctx requests no warnings.
+func reportWarnings(ctx context.Context, err error) error {
+	if ctx != nil {
+		if requested, _ := ctx.Value(warningsKey{}).(bool); requested {
+			return err
+		}
+	}
+	errs, _ := SplitWarnings(err)
+	return errs
+}
+
+// markWarnings marks every leaf error of err as a warning. Internal errors are kept as they are.
+func markWarnings(err error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case InternalError:
+		return err
+	case Errors:
+		warnings := Errors{}
+		for key, value := range e {
+			warnings[key] = markWarnings(value)
+		}
+		return warnings
+	case OrderedErrors:
+		warnings := OrderedErrors{Errors: Errors{}}
+		for _, key := range e.keys {
+			warnings.add(key, markWarnings(e.Errors[key]))
+		}
+		return warnings
+	case KeyedErrors:
+		return e.withErrors(markWarnings(e.Errors))
+	case FieldErrors:
+		warnings := make([]error, len(e))
+		for i, fe := range e {
+			warnings[i] = markWarnings(fe.Err)
+		}
+		return e.withErrors(warnings)
+	}
+	if SeverityOf(err) == SeverityWarning {
+		return err
+	}
+	return warningError{err}
+}
+
+// isWarning reports whether a validation error is made of warnings only.
+func isWarning(err error) bool {
+	errs, _ := SplitWarnings(err)
+	return errs == nil
+}
+
+// SplitWarnings separates the warnings found in a validation error from the other errors. Nested Errors,
+// OrderedErrors, KeyedErrors and FieldErrors are split recursively, keeping the keys under which the errors are found. Either result is nil
+// if there is no error of its kind. For example,
+//
+//	err, warnings := valid.SplitWarnings(valid.ValidateStructWithContext(valid.WithWarnings(ctx), &u, ...))
+func SplitWarnings(err error) (errs, warnings error) {
+	switch e := err.(type) {
+	case nil:
+		return nil, nil
+	case Errors:
+		es, ws := Errors{}, Errors{}
+		for key, value := range e {
+			ve, vw := SplitWarnings(value)
+			if ve != nil {
+				es[key] = ve
+			}
+			if vw != nil {
+				ws[key] = vw
+			}
+		}
+		return es.Filter(), ws.Filter()
+	case OrderedErrors:
+		es, ws := OrderedErrors{Errors: Errors{}}, OrderedErrors{Errors: Errors{}}
+		for _, key := range e.keys {
+			ve, vw := SplitWarnings(e.Errors[key])
+			if ve != nil {
+				es.add(key, ve)
+			}
+			if vw != nil {
+				ws.add(key, vw)
+			}
+		}
+		if len(es.keys) > 0 {
+			errs = es
+		}
+		if len(ws.keys) > 0 {
+			warnings = ws
+		}
+		return errs, warnings
+	case KeyedErrors:
+		errs, warnings = SplitWarnings(e.Errors)
+		return e.withErrors(errs), e.withErrors(warnings)
+	case FieldErrors:
+		es, ws := make([]error, len(e)), make([]error, len(e))
+		for i, fe := range e {
+			es[i], ws[i] = SplitWarnings(fe.Err)
+		}
+		if fes := e.withErrors(es); fes != nil {
+			errs = fes
+		}
+		if fws := e.withErrors(ws); fws != nil {
+			warnings = fws
+		}
+		return errs, warnings
+	}
+	if SeverityOf(err) == SeverityWarning {
+		return nil, err
+	}
+	return err, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverity_String(t *testing.T) {
+	assert.Equal(t, "error", SeverityError.String())
+	assert.Equal(t, "warning", SeverityWarning.String())
+}
+
+func TestWarn(t *testing.T) {
+	ctx := WithWarnings(context.Background())
+	r := Warn(Length(5, 0).Error("should be longer"))
+	assert.Nil(t, ValidateWithContext(ctx, "abcdef", r))
+	err := ValidateWithContext(ctx, "abc", r)
+	assertError(t, "should be longer", err, "t1")
+	assert.Equal(t, SeverityWarning, SeverityOf(err))
+	assert.Equal(t, "should be longer", err.(interface{ Unwrap() error }).Unwrap().Error())
+
+	// a rule error is still an Error underneath
+	var e Error
+	if assert.True(t, errors.As(err, &e)) {
+		assert.Equal(t, "validation_length_too_short", e.Code())
+	}
+
+	// every leaf of nested errors is marked as a warning
+	err = ValidateWithContext(ctx, []string{"", "a", ""}, Warn(Each(Required)))
+	assertError(t, "0: cannot be blank; 2: cannot be blank.", err, "t2")
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.Equal(t, SeverityWarning, SeverityOf(es["0"]))
+		assert.Equal(t, SeverityWarning, SeverityOf(es["2"]))
+	}
+
+	// internal errors are not turned into warnings, and are returned even if warnings are not requested
+	internal := Warn(By(func(interface{}) error { return NewInternalError(errors.New("boom")) }))
+	err = ValidateWithContext(ctx, "a", internal)
+	assert.Equal(t, SeverityError, SeverityOf(err))
+	assertError(t, "boom", Validate("a", internal), "t3")
+
+	err = Warn(WithContext(func(ctx context.Context, value interface{}) error {
+		return errors.New(ctx.Value(contains).(string))
+	})).(RuleWithContext).ValidateWithContext(context.WithValue(ctx, contains, "abc"), "x")
+	assertError(t, "abc", err, "t4")
+	assert.Equal(t, SeverityWarning, SeverityOf(err))
+}
+
+func TestWarn_NotRequested(t *testing.T) {
+	// warnings do not make the validation fail unless they are requested
+	r := Warn(Length(5, 0))
+	assert.Nil(t, r.Validate("ab"))
+	assert.Nil(t, Validate("ab", r))
+	assert.Nil(t, ValidateWithContext(context.Background(), "ab", r))
+	assert.Nil(t, Validate([]string{"", "a"}, Each(Warn(Required))))
+	assert.Nil(t, ValidateWithContext(WithLocale(context.Background(), "fr"), []string{""}, Each(Warn(Required))))
+	assertError(t, "the length must be no more than 1", Validate("ab", r, Length(0, 1)), "t1")
+
+	// the context passed to a nested ValidatableWithContext keeps requesting the warnings
+	ctx := WithWarnings(context.Background())
+	assertError(t, "0: the length must be no less than 5.", ValidateWithContext(ctx, []string{"ab"}, Each(r)), "t2")
+}
+
+func TestWarn_FollowingRules(t *testing.T) {
+	ctx := WithWarnings(context.Background())
+	weak := Warn(Length(5, 0).Error("should be longer"))
+	tests := []struct {
+		tag      string
+		value    interface{}
+		rules    []Rule
+		expected string
+		severity Severity
+	}{
+		{"t1", "abc", []Rule{weak, In("zzz")}, "must be a valid value", SeverityError},
+		{"t2", "abc", []Rule{weak, In("abc")}, "should be longer", SeverityWarning},
+		{"t3", "abc", []Rule{weak, Warn(In("zzz")), Required}, "should be longer", SeverityWarning},
+		{"t4", "abc", []Rule{weak, Skip, In("zzz")}, "should be longer", SeverityWarning},
+		{"t5", 3, []Rule{Warn(Min(5)), Max(2)}, "must be no greater than 2", SeverityError},
+		{"t6", true, []Rule{Warn(In(false)), Nil}, "must be blank", SeverityError},
+		{"t7", []string{"a"}, []Rule{Warn(Length(2, 0)), Each(In("b"))}, "0: must be a valid value.", SeverityError},
+		{"t8", String123("abc"), []Rule{Warn(In("zzz"))}, "error 123", SeverityError},
+		{"t9", String123("123"), []Rule{Warn(In("zzz"))}, "must be a valid value", SeverityWarning},
+	}
+	for _, test := range tests {
+		err := ValidateWithContext(ctx, test.value, test.rules...)
+		assertError(t, test.expected, err, test.tag)
+		assert.Equal(t, test.severity, SeverityOf(err), test.tag)
+
+		// without requesting them, only the errors that are not warnings are returned
+		expected := test.expected
+		if test.severity == SeverityWarning {
+			expected = ""
+		}
+		assertError(t, expected, Validate(test.value, test.rules...), test.tag)
+	}
+}
+
+func TestSeverityOf(t *testing.T) {
+	assert.Equal(t, SeverityError, SeverityOf(nil))
+	assert.Equal(t, SeverityError, SeverityOf(errors.New("abc")))
+	assert.Equal(t, SeverityError, SeverityOf(ErrRequired))
+	assert.Equal(t, SeverityWarning, SeverityOf(warningError{ErrRequired}))
+	assert.Equal(t, SeverityWarning, SeverityOf(RuleError{err: warningError{ErrRequired}}))
+}
+
+func TestSplitWarnings(t *testing.T) {
+	warning := warningError{errors.New("weak")}
+	hard := errors.New("bad")
+
+	errs, warnings := SplitWarnings(nil)
+	assert.Nil(t, errs)
+	assert.Nil(t, warnings)
+
+	errs, warnings = SplitWarnings(hard)
+	assert.Equal(t, hard, errs)
+	assert.Nil(t, warnings)
+
+	errs, warnings = SplitWarnings(warning)
+	assert.Nil(t, errs)
+	assert.Equal(t, warning, warnings)
+
+	err := Errors{
+		"a": hard,
+		"b": warning,
+		"c": Errors{"x": hard, "y": warning},
+		"d": Errors{"z": warning},
+	}
+	errs, warnings = SplitWarnings(err)
+	assertError(t, "a: bad; c: (x: bad.).", errs, "t1")
+	assertError(t, "b: weak; c: (y: weak.); d: (z: weak.).", warnings, "t2")
+	assert.Equal(t, 5, err.Count())
+
+	ordered := OrderedErrors{}
+	ordered.add("b", warning)
+	ordered.add("a", hard)
+	ordered.add("c", warning)
+	errs, warnings = SplitWarnings(ordered)
+	assertError(t, "a: bad.", errs, "t3")
+	assertError(t, "b: weak; c: weak.", warnings, "t4")
+
+	fields := FieldErrors{
+		{Field: "b", Message: "weak", Err: warning},
+		{Field: "a", Message: "bad", Err: hard},
+	}
+	errs, warnings = SplitWarnings(fields)
+	assert.Equal(t, FieldErrors{{Field: "a", Message: "bad", Err: hard}}, errs)
+	assert.Equal(t, FieldErrors{{Field: "b", Message: "weak", Err: warning}}, warnings)
+
+	errs, warnings = SplitWarnings(Errors{"a": hard})
+	assert.NotNil(t, errs)
+	assert.Nil(t, warnings)
+}
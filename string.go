@@ -62,3 +62,11 @@ func (r StringRule) Validate(value interface{}) error {
 
 	return r.err
 }
+
+// validateString checks a string without reflection. It returns the same result as Validate.
+func (r StringRule) validateString(value string) error {
+	if value == "" || r.validate(value) {
+		return nil
+	}
+	return r.err
+}
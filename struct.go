@@ -15,6 +15,10 @@ import (
 var (
 	// ErrStructPointer is the error that a struct being validated is not specified as a pointer.
 	ErrStructPointer = errors.New("only a pointer to a struct can be validated")
+
+	// ErrAbort is the error that signals ValidateStruct to stop validating the remaining fields.
+	// Use Abort() to attach it to a validation error, or return it directly from a rule.
+	ErrAbort = errors.New("validation aborted")
 )
 
 type (
@@ -26,11 +30,63 @@ type (
 
 	// FieldRules represents a rule set associated with a struct field.
 	FieldRules struct {
-		fieldPtr interface{}
-		rules    []Rule
+		fieldPtr      interface{}
+		rules         []Rule
+		skipNil       bool
+		groups        []string
+		onlyIf        func() bool
+		onlyIfContext func(ctx context.Context) bool
+	}
+
+	// StructValidator validates a struct against a list of field rules, with options
+	// controlling how the validation is performed and how its errors are reported.
+	StructValidator struct {
+		structPtr interface{}
+		fields    []*FieldRules
+		ordered   bool
+		tag       *string
+		warnings  *Errors
+		observer  Observer
+		failFast  bool
+		groups    []string
+		asSlice   bool
+	}
+
+	// Observer is notified by StructValidator before and after each struct field is validated.
+	// It is meant for auditing, metrics (e.g. the validation time of each field) and debugging.
+	Observer interface {
+		// BeforeField is called before the field with the given name and value is validated.
+		BeforeField(name string, value interface{})
+		// AfterField is called after the field with the given name and value is validated, with the
+		// error returned by its rules, or nil if the field is valid.
+		AfterField(name string, value interface{}, err error)
 	}
 )
 
+// abortError wraps a validation error that should stop the struct validation.
+type abortError struct {
+	error
+}
+
+// Is reports whether the target is ErrAbort.
+func (e abortError) Is(target error) bool {
+	return target == ErrAbort
+}
+
+// Unwrap returns the validation error that it wraps around.
+func (e abortError) Unwrap() error {
+	return e.error
+}
+
+// Abort wraps a validation error so that ValidateStruct stops validating the fields following the one
+// that failed. The errors found so far, including the given one, are still reported.
+// This is an advanced control-flow feature for the cases when the failure of a field (e.g. a malformed
+// discriminator) makes validating the rest of the struct meaningless. Note that the abort only applies to
+// the struct directly being validated and does not propagate to the structs containing it.
+func Abort(err error) error {
+	return abortError{err}
+}
+
 // Error returns the error string of ErrFieldPointer.
 func (e ErrFieldPointer) Error() string {
 	return fmt.Sprintf("field #%v must be specified as a pointer", int(e))
@@ -45,6 +101,12 @@ func (e ErrFieldNotFound) Error() string {
 // Note that the struct being validated must be specified as a pointer to it. If the pointer is nil, it is considered valid.
 // Use Field() to specify struct fields that need to be validated. Each Field() call specifies a single field which
 // should be specified as a pointer to the field. A field can be associated with multiple rules.
+// If a field (or the value it points to) implements Validatable, its Validate() method is called after the rules pass.
+// This includes a Validate() method with a pointer receiver, in which case the rules are given the value of the field
+// and the Validate() method is called on the pointer to the field.
+// A nil pointer to a nested struct is considered valid unless it is checked by a rule such as Required or NotNil.
+// The promoted fields of an embedded struct pointer can only be specified when the pointer is not nil, so rules for
+// them should be added conditionally, or the embedded pointer itself should be validated instead.
 // For example,
 //
 //	value := struct {
@@ -60,7 +122,7 @@ func (e ErrFieldNotFound) Error() string {
 //
 // An error will be returned if validation fails.
 func ValidateStruct(structPtr interface{}, fields ...*FieldRules) error {
-	return ValidateStructWithContext(nil, structPtr, fields...)
+	return Struct(structPtr, fields...).validate(nil)
 }
 
 // ValidateStructWithContext validates a struct with the given context.
@@ -68,7 +130,122 @@ func ValidateStruct(structPtr interface{}, fields ...*FieldRules) error {
 // validate struct fields with the provided context.
 // Please refer to ValidateStruct for the detailed instructions on how to use this function.
 func ValidateStructWithContext(ctx context.Context, structPtr interface{}, fields ...*FieldRules) error {
-	value := reflect.ValueOf(structPtr)
+	return Struct(structPtr, fields...).validate(ctx)
+}
+
+// Struct returns a StructValidator that validates the given struct against the specified field rules.
+// Struct(structPtr, fields...).Validate() is equivalent to ValidateStruct(structPtr, fields...), while
+// the methods of StructValidator allow customizing the validation. For example,
+//
+//	err := valid.Struct(&c,
+//	    valid.Field(&c.Name, valid.Required),
+//	    valid.Field(&c.Email, valid.Required, is.Email),
+//	).InDeclarationOrder().Validate()
+//
+// Please refer to ValidateStruct for the detailed instructions on how to specify the struct and the fields.
+func Struct(structPtr interface{}, fields ...*FieldRules) StructValidator {
+	return StructValidator{
+		structPtr: structPtr,
+		fields:    fields,
+	}
+}
+
+// InDeclarationOrder configures the validator to report the field errors in the order the fields are declared
+// in the rule set (i.e. the order of the Field() arguments) rather than in alphabetical order.
+// The errors are then returned as OrderedErrors instead of Errors.
+func (v StructValidator) InDeclarationOrder() StructValidator {
+	v.ordered = true
+	return v
+}
+
+// ErrorTag configures the validator to name the field errors after the given struct tag instead of valid.ErrorTag
+// (e.g. "yaml" or "form"). The name in the tag is used with any options such as "omitempty" stripped. A field without
+// the tag, or whose tag name is empty or "-", is named after the field itself. An empty tag name makes the validator
+// always use the field names. The setting only applies to the fields of this struct; nested structs are named
+// according to the way they are validated themselves.
+func (v StructValidator) ErrorTag(tag string) StructValidator {
+	v.tag = &tag
+	return v
+}
+
+// CollectWarnings configures the validator to request the warnings (see Warn) found in the fields and store them
+// into dst, keyed like the errors. The validation error returned only includes the other errors, as it does without
+// CollectWarnings. dst is reset at the start of every validation and is left empty if no warnings are found.
+// Note that the warnings of a nested struct are only collected if they are returned by its validation, i.e. it
+// validates itself with ValidateStructWithContext using the given context, without CollectWarnings.
+func (v StructValidator) CollectWarnings(dst *Errors) StructValidator {
+	v.warnings = dst
+	return v
+}
+
+// WithObserver configures the validator to notify obs before and after each field is validated. The field is
+// named as in the validation errors, and its error is given as returned by its rules. Fields skipped by SkipNil
+// are not reported. The observer cannot alter the validation outcome.
+func (v StructValidator) WithObserver(obs Observer) StructValidator {
+	v.observer = obs
+	return v
+}
+
+// FailFast configures the validator to stop at the first field that fails, skipping the remaining fields.
+// This saves the work of validating the whole struct when a reject decision is all that is needed. The error
+// returned is then the error of that field alone, as a FieldError naming the field, or as FieldErrors listing it
+// if AsSlice is called too. The fields are validated in the order they are specified, and a nested struct is still
+// validated as a whole; for an embedded struct, the first of its fields that fails is reported. A field with only
+// warnings (see Warn) does not stop the validation, and its warnings are only reported through CollectWarnings.
+func (v StructValidator) FailFast() StructValidator {
+	v.failFast = true
+	return v
+}
+
+// ForGroups configures the validator to activate the given validation groups, so that the rules of the fields
+// assigned to any of them with FieldRules.Groups are applied. The rules of the fields without groups are always
+// applied. When no group is active, which is the default, only the fields without groups are validated. This allows
+// a single rule set to serve different scenarios, e.g. creating and updating a record:
+//
+//	err := valid.Struct(&u,
+//	    valid.Field(&u.Name, valid.Required),
+//	    valid.Field(&u.ID, valid.Empty).Groups("create"),
+//	    valid.Field(&u.ID, valid.Required).Groups("update"),
+//	).ForGroups("create").Validate()
+//
+// The groups only apply to the fields of this struct; nested structs are validated as they validate themselves.
+func (v StructValidator) ForGroups(groups ...string) StructValidator {
+	v.groups = groups
+	return v
+}
+
+// AsSlice configures the validator to return the field errors as FieldErrors, a list that keeps the order in which
+// the fields are specified and serializes naturally to an ordered list of errors. The errors of nested structs,
+// maps and slices are flattened into the list, with their paths joined by dots (e.g. "Address.Zip"). A field that
+// is specified more than once is listed once for every Field() that fails.
+func (v StructValidator) AsSlice() StructValidator {
+	v.asSlice = true
+	return v
+}
+
+// Validate validates the struct and returns the validation error, if any.
+func (v StructValidator) Validate() error {
+	return v.validate(nil)
+}
+
+// ValidateWithContext validates the struct with the given context and returns the validation error, if any.
+func (v StructValidator) ValidateWithContext(ctx context.Context) error {
+	return v.validate(ctx)
+}
+
+// validate validates the struct. If ctx is nil, the fields are validated without a context.
+func (v StructValidator) validate(ctx context.Context) error {
+	// the fields are validated with a context requesting the warnings if they are collected
+	fctx := ctx
+	if v.warnings != nil {
+		*v.warnings = Errors{}
+		if fctx == nil {
+			fctx = context.Background()
+		}
+		fctx = WithWarnings(fctx)
+	}
+	fields := v.fields
+	value := reflect.ValueOf(v.structPtr)
 	if value.Kind() != reflect.Ptr || !value.IsNil() && value.Elem().Kind() != reflect.Struct {
 		// must be a pointer to a struct
 		return NewInternalError(ErrStructPointer)
@@ -79,7 +256,15 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 	}
 	value = value.Elem()
 
-	errs := Errors{}
+	errs := OrderedErrors{Errors: Errors{}}
+	var fieldErrs FieldErrors
+	var failed *FieldError
+	add := func(name string, err error) {
+		errs.add(name, err)
+		if v.asSlice {
+			fieldErrs = appendFieldErrors(fieldErrs, name, err)
+		}
+	}
 
 	for i, fr := range fields {
 		fv := reflect.ValueOf(fr.fieldPtr)
@@ -90,33 +275,149 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 		if ft == nil {
 			return NewInternalError(ErrFieldNotFound(i))
 		}
-		var err error
-		if ctx == nil {
-			err = Validate(fv.Elem().Interface(), fr.rules...)
-		} else {
-			err = ValidateWithContext(ctx, fv.Elem().Interface(), fr.rules...)
+		if !v.inGroups(fr) || !fr.included(ctx) {
+			continue
 		}
-		if err != nil {
-			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
-				return err
-			}
-			if ft.Anonymous {
-				// merge errors from anonymous struct field
-				if es, ok := err.(Errors); ok {
-					for name, value := range es {
-						errs[name] = value
-					}
+		if fr.skipNil {
+			switch fe := fv.Elem(); fe.Kind() {
+			case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+				if fe.IsNil() {
 					continue
 				}
 			}
-			errs[getErrorFieldName(ft)] = err
+		}
+		name := v.fieldName(ft)
+		fieldValue := fv.Elem().Interface()
+		if v.observer != nil {
+			v.observer.BeforeField(name, fieldValue)
+		}
+		// the pointer to the field is validated if only the pointer implements Validatable (or ValidatableWithContext)
+		err := validateReflectValue(fctx, fv.Elem(), fr.rules)
+		if v.observer != nil {
+			v.observer.AfterField(name, fieldValue, err)
+		}
+		if err == nil {
+			continue
+		}
+		if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+			return err
+		}
+		aborted := errors.Is(err, ErrAbort)
+		if ae, ok := err.(abortError); ok {
+			err = ae.error
+		}
+		err = withErrorField(err, name)
+		es, isErrors := err.(Errors)
+		oes, isOrdered := err.(OrderedErrors)
+		switch {
+		case isErrors && ft.Anonymous:
+			// merge errors from anonymous struct field
+			for _, name := range es.sortedKeys() {
+				add(name, es[name])
+			}
+		case isOrdered && ft.Anonymous:
+			for _, name := range oes.keys {
+				add(name, oes.Errors[name])
+			}
+		default:
+			add(name, err)
+		}
+		if v.failFast && !isWarning(err) {
+			failed = v.failedField(name, err, ft.Anonymous)
+			break
+		}
+		if aborted {
+			break
+		}
+	}
+
+	if v.warnings != nil {
+		es, ws := SplitWarnings(errs)
+		if ws != nil {
+			*v.warnings = ws.(OrderedErrors).Errors
+		}
+		errs = OrderedErrors{Errors: Errors{}}
+		if es != nil {
+			errs = es.(OrderedErrors)
 		}
 	}
 
-	if len(errs) > 0 {
+	if failed != nil && !v.asSlice {
+		return *failed
+	}
+	if v.asSlice {
+		if v.warnings != nil {
+			es, _ := SplitWarnings(fieldErrs)
+			fieldErrs, _ = es.(FieldErrors)
+		}
+		if len(fieldErrs) == 0 {
+			return nil
+		}
+		return fieldErrs
+	}
+	if len(errs.keys) == 0 {
+		return nil
+	}
+	if v.ordered {
 		return errs
 	}
-	return nil
+	return errs.Errors
+}
+
+// failedField returns the error of the field that stops a FailFast validation as a FieldError. The error of an
+// embedded struct is reported for the first of its fields that fails. The warnings are left out if they are collected.
+func (v StructValidator) failedField(name string, err error, anonymous bool) *FieldError {
+	if anonymous {
+		var keys []string
+		var es Errors
+		switch e := err.(type) {
+		case Errors:
+			keys, es = e.sortedKeys(), e
+		case OrderedErrors:
+			keys, es = e.keys, e.Errors
+		}
+		for _, key := range keys {
+			if !isWarning(es[key]) {
+				name, err = key, es[key]
+				break
+			}
+		}
+	}
+	if v.warnings != nil {
+		err, _ = SplitWarnings(err)
+	}
+	return &FieldError{Field: name, Message: err.Error(), Err: err}
+}
+
+// appendFieldErrors appends the leaf errors of the given field error to FieldErrors.
+func appendFieldErrors(es FieldErrors, name string, err error) FieldErrors {
+	w, ok := err.(interface {
+		Walk(fn func(path []string, err error))
+	})
+	if !ok {
+		return append(es, FieldError{Field: name, Message: err.Error(), Err: err})
+	}
+	w.Walk(func(path []string, err error) {
+		field := strings.Join(append([]string{name}, path...), ".")
+		es = append(es, FieldError{Field: field, Message: err.Error(), Err: err})
+	})
+	return es
+}
+
+// inGroups reports whether the field rules apply to the active groups, i.e. they have no groups
+// or one of their groups is active.
+func (v StructValidator) inGroups(fr *FieldRules) bool {
+	if len(fr.groups) == 0 {
+		return true
+	}
+	for _, g := range fr.groups {
+		for _, active := range v.groups {
+			if g == active {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // Field specifies a struct field and the corresponding validation rules.
@@ -128,6 +429,59 @@ func Field(fieldPtr interface{}, rules ...Rule) *FieldRules {
 	}
 }
 
+// SkipNil configures the field rules to be skipped when the field is nil, and applied as usual when it is set.
+// This is meant for pointer fields in sparse updates (e.g. PATCH requests decoded from JSON), where a nil pointer
+// means the field is not provided and should be left alone:
+//
+//	valid.Field(&p.Name, valid.Required, valid.Length(1, 50)).SkipNil()
+//
+// It only makes sense for pointer fields (and interface, map or slice fields); other fields are never nil, so their
+// rules always apply.
+func (r *FieldRules) SkipNil() *FieldRules {
+	r.skipNil = true
+	return r
+}
+
+// Groups assigns the field rules to the given validation groups, so that they are only applied when the struct is
+// validated with one of the groups activated by StructValidator.ForGroups. Please refer to ForGroups for more details.
+// Calling Groups more than once adds to the groups.
+func (r *FieldRules) Groups(groups ...string) *FieldRules {
+	r.groups = append(r.groups, groups...)
+	return r
+}
+
+// OnlyIf configures the field rules to be applied only if the given predicate returns true. The predicate is called
+// every time the struct is validated, so it can read the other fields of the struct, e.g. to check the tax only
+// for the taxable items:
+//
+//	valid.Field(&item.Tax, valid.Required, valid.Min(0)).OnlyIf(func() bool { return item.Taxable })
+//
+// When the predicate returns false, none of the rules is applied and the field is not validated with its own
+// Validate() method either, so it cannot produce an error. This is the same as wrapping the rules with When,
+// but decided for the whole field. Calling OnlyIf again replaces the predicate.
+func (r *FieldRules) OnlyIf(predicate func() bool) *FieldRules {
+	r.onlyIf = predicate
+	return r
+}
+
+// OnlyIfContext is like OnlyIf, but the predicate decides from the validation context if the field rules should be
+// applied, e.g. from the current step of a multi-step form. The predicate only takes effect when the struct is
+// validated with a context (e.g. via ValidateStructWithContext). Without a context, the field rules are applied as
+// if the predicate returned true. OnlyIf and OnlyIfContext can be combined, in which case both must return true.
+func (r *FieldRules) OnlyIfContext(predicate func(ctx context.Context) bool) *FieldRules {
+	r.onlyIfContext = predicate
+	return r
+}
+
+// included reports whether the field rules should be applied according to the predicates of OnlyIf and OnlyIfContext.
+// If ctx is nil, the predicate of OnlyIfContext is not called.
+func (r *FieldRules) included(ctx context.Context) bool {
+	if r.onlyIf != nil && !r.onlyIf() {
+		return false
+	}
+	return ctx == nil || r.onlyIfContext == nil || r.onlyIfContext(ctx)
+}
+
 // findStructField looks for a field in the given struct.
 // The field being looked for should be a pointer to the actual struct field.
 // If found, the field info will be returned. Otherwise, nil will be returned.
@@ -158,9 +512,26 @@ func findStructField(structValue reflect.Value, fieldValue reflect.Value) *refle
 	return nil
 }
 
+// fieldName returns the name that should be used to represent the validation error of a struct field.
+func (v StructValidator) fieldName(f *reflect.StructField) string {
+	if v.tag != nil {
+		return getFieldNameByTag(f, *v.tag)
+	}
+	return getErrorFieldName(f)
+}
+
 // getErrorFieldName returns the name that should be used to represent the validation error of a struct field.
 func getErrorFieldName(f *reflect.StructField) string {
-	if tag := f.Tag.Get(ErrorTag); tag != "" && tag != "-" {
+	return getFieldNameByTag(f, ErrorTag)
+}
+
+// getFieldNameByTag returns the name of a struct field given by the specified struct tag.
+// If the tag is empty or does not give a name, the field name is returned.
+func getFieldNameByTag(f *reflect.StructField, tagName string) string {
+	if tagName == "" {
+		return f.Name
+	}
+	if tag := f.Tag.Get(tagName); tag != "" && tag != "-" {
 		if cps := strings.SplitN(tag, ",", 2); cps[0] != "" {
 			return cps[0]
 		}
@@ -6,7 +6,10 @@ package valid
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -145,6 +148,566 @@ func TestValidateStruct(t *testing.T) {
 	assert.EqualError(t, err, "Value: the length must be between 5 and 10.")
 }
 
+func TestValidateStruct_PointerToCollection(t *testing.T) {
+	type patch struct {
+		Tags   *[]string
+		Labels *map[string]string
+	}
+	var p0 patch
+	p1 := patch{Tags: &[]string{}, Labels: &map[string]string{}}
+	p2 := patch{Tags: &[]string{"abc", "", "abcdef"}, Labels: &map[string]string{"a": ""}}
+	tests := []struct {
+		tag   string
+		model *patch
+		rules func(p *patch) []*FieldRules
+		err   string
+	}{
+		{"t1.1", &p0, func(p *patch) []*FieldRules { return []*FieldRules{Field(&p.Tags, Required)} }, "Tags: cannot be empty."},
+		{"t1.2", &p0, func(p *patch) []*FieldRules { return []*FieldRules{Field(&p.Tags, NotNil)} }, "Tags: is required."},
+		{"t1.3", &p0, func(p *patch) []*FieldRules {
+			return []*FieldRules{Field(&p.Tags, Each(Required), Length(1, 2)), Field(&p.Labels, Each(Required))}
+		}, ""},
+		{"t2.1", &p1, func(p *patch) []*FieldRules { return []*FieldRules{Field(&p.Tags, Required)} }, "Tags: cannot be empty."},
+		{"t2.2", &p1, func(p *patch) []*FieldRules { return []*FieldRules{Field(&p.Tags, NotNil)} }, ""},
+		{"t2.3", &p1, func(p *patch) []*FieldRules {
+			return []*FieldRules{Field(&p.Tags, Each(Required), Length(1, 2)), Field(&p.Labels, Each(Required))}
+		}, ""},
+		{"t3.1", &p2, func(p *patch) []*FieldRules { return []*FieldRules{Field(&p.Tags, Required)} }, ""},
+		{"t3.2", &p2, func(p *patch) []*FieldRules { return []*FieldRules{Field(&p.Tags, Length(1, 2))} }, "Tags: the length must be between 1 and 2."},
+		{"t3.3", &p2, func(p *patch) []*FieldRules {
+			return []*FieldRules{Field(&p.Tags, Each(Required, Length(0, 5))), Field(&p.Labels, Each(Required))}
+		}, "Labels: (a: cannot be blank.); Tags: (1: cannot be blank; 2: the length must be no more than 5.)."},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.model, test.rules(test.model)...)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestValidateStruct_NestedStructPointer(t *testing.T) {
+	type customer struct {
+		Name    string
+		Address *Model3
+	}
+	c0 := customer{Name: "abc"}
+	c1 := customer{Name: "abc", Address: &Model3{A: "xyz"}}
+	c2 := customer{Name: "abc", Address: &Model3{A: "abc"}}
+	tests := []struct {
+		tag   string
+		model *customer
+		rules func(c *customer) []*FieldRules
+		err   string
+	}{
+		{"t1.1", &c0, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, ""},
+		{"t1.2", &c0, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Required)} }, "Address: is required."},
+		{"t2.1", &c1, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, "Address: (A: error abc.)."},
+		{"t2.2", &c1, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Required)} }, "Address: (A: error abc.)."},
+		{"t3.1", &c2, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, ""},
+		{"t3.2", &c2, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Required)} }, ""},
+	}
+	for _, test := range tests {
+		err1 := ValidateStruct(test.model, test.rules(test.model)...)
+		err2 := ValidateStructWithContext(context.Background(), test.model, test.rules(test.model)...)
+		assertError(t, test.err, err1, test.tag)
+		assertError(t, test.err, err2, test.tag)
+	}
+}
+
+// auditInfo is embedded by pointer in the tests of promoted fields.
+type auditInfo struct {
+	CreatedBy string
+	Note      string
+}
+
+func (a auditInfo) Validate() error {
+	return ValidateStruct(&a, Field(&a.CreatedBy, Required))
+}
+
+func TestValidateStruct_EmbeddedPointer(t *testing.T) {
+	type document struct {
+		*auditInfo
+		*Struct2
+		Title string
+	}
+
+	// the promoted fields of a populated embedded pointer are resolved and named as if they belong to the struct
+	d := document{auditInfo: &auditInfo{Note: "x"}, Struct2: &Struct2{}}
+	err := ValidateStruct(&d,
+		Field(&d.CreatedBy, Required),
+		Field(&d.Field21, Required),
+		Field(&d.Struct2.Field22, Length(2, 0)),
+		Field(&d.Title, Required),
+	)
+	assertError(t, "CreatedBy: cannot be blank; Field21: cannot be blank; Title: cannot be blank.", err, "t1")
+	d.Struct2.Field22 = "a"
+	err = ValidateStruct(&d, Field(&d.Field22, Length(2, 0)))
+	assertError(t, "Field22: the length must be no less than 2.", err, "t2")
+
+	// a Validatable embedded pointer is validated as a whole, with its errors merged into the struct
+	err = ValidateStruct(&d, Field(&d.auditInfo), Field(&d.Title, Required))
+	assertError(t, "CreatedBy: cannot be blank; Title: cannot be blank.", err, "t3")
+
+	// a nil embedded pointer is valid unless it is required, and its promoted fields cannot be specified,
+	// so their rules are only added when it is set
+	d = document{Title: "a"}
+	assert.Nil(t, ValidateStruct(&d, Field(&d.auditInfo), Field(&d.Struct2), Field(&d.Title, Required)))
+	err = ValidateStruct(&d, Field(&d.auditInfo, Required), Field(&d.Struct2, NotNil))
+	assertError(t, "Struct2: is required; auditInfo: is required.", err, "t4")
+	fields := []*FieldRules{Field(&d.Title, Required)}
+	if d.Struct2 != nil {
+		fields = append(fields, Field(&d.Field21, Required))
+	}
+	assert.Nil(t, ValidateStruct(&d, fields...))
+
+	// a pointer to a field of another struct is not found rather than causing a panic
+	other := Struct2{}
+	err = ValidateStruct(&d, Field(&other.Field21, Required))
+	assert.EqualError(t, err, ErrFieldNotFound(0).Error())
+}
+
+func TestValidateStruct_MapField(t *testing.T) {
+	type service struct {
+		Config  map[string]string
+		Limits  *map[string]int
+		Options map[string]interface{}
+	}
+	rules := func(s *service) []*FieldRules {
+		return []*FieldRules{
+			Field(&s.Config, Map(Key("timeout", Required, Length(2, 3)), Key("region", In("eu", "us")).Optional())),
+			Field(&s.Limits, Map(Key("rps", Min(1))).AllowExtraKeys()),
+			Field(&s.Options, Map(Key("tls", Map(Key("cert", Required))))),
+		}
+	}
+
+	limits := map[string]int{"rps": 10, "burst": 20}
+	s := service{
+		Config:  map[string]string{"timeout": "30", "region": "eu"},
+		Limits:  &limits,
+		Options: map[string]interface{}{"tls": map[string]interface{}{"cert": "x"}},
+	}
+	assert.Nil(t, ValidateStruct(&s, rules(&s)...))
+
+	// the errors of the map keys are nested under the field names
+	limits["rps"] = -1
+	s.Config = map[string]string{"timeout": "", "region": "cn", "debug": "on"}
+	s.Options = map[string]interface{}{"tls": map[string]interface{}{}}
+	err := ValidateStruct(&s, rules(&s)...)
+	assertError(t, "Config: (debug: key not expected; region: must be a valid value; timeout: cannot be blank.); "+
+		"Limits: (rps: must be no less than 1.); Options: (tls: (cert: required key is missing.).).", err, "t1")
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.IsType(t, Errors{}, es["Config"])
+	}
+
+	// nil maps are valid unless they are required
+	s = service{}
+	assert.Nil(t, ValidateStruct(&s, rules(&s)...))
+	err = ValidateStruct(&s, Field(&s.Config, Required, Map(Key("timeout", Required))))
+	assertError(t, "Config: cannot be empty.", err, "t2")
+}
+
+func TestValidateStruct_InterfaceField(t *testing.T) {
+	type customer struct {
+		Name    string
+		Address interface{}
+	}
+	c0 := customer{Name: "abc"}
+	c1 := customer{Name: "abc", Address: Model3{A: "xyz"}}
+	c2 := customer{Name: "abc", Address: &Model3{A: "xyz"}}
+	c3 := customer{Name: "abc", Address: Model3{A: "abc"}}
+	c4 := customer{Name: "abc", Address: Model4{A: "xyz"}}
+	c5 := customer{Name: "abc", Address: "xyz"}
+	c6 := customer{Name: "abc", Address: (*Model3)(nil)}
+	tests := []struct {
+		tag   string
+		model *customer
+		rules func(c *customer) []*FieldRules
+		err   string
+	}{
+		{"t1.1", &c0, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, ""},
+		{"t1.2", &c0, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Required)} }, "Address: cannot be blank."},
+		{"t2.1", &c1, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, "Address: (A: error abc.)."},
+		{"t2.2", &c2, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, "Address: (A: error abc.)."},
+		{"t2.3", &c3, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Required)} }, ""},
+		{"t2.4", &c1, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Skip)} }, ""},
+		{"t3.1", &c5, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address, Length(5, 10))} }, "Address: the length must be between 5 and 10."},
+		{"t3.2", &c6, func(c *customer) []*FieldRules { return []*FieldRules{Field(&c.Address)} }, ""},
+	}
+	for _, test := range tests {
+		err1 := ValidateStruct(test.model, test.rules(test.model)...)
+		err2 := ValidateStructWithContext(context.Background(), test.model, test.rules(test.model)...)
+		assertError(t, test.err, err1, test.tag)
+		assertError(t, test.err, err2, test.tag)
+	}
+
+	// a context-aware value is validated with the context
+	err := ValidateStructWithContext(context.Background(), &c4, Field(&c4.Address))
+	assertError(t, "Address: (A: error abc.).", err, "t4.1")
+}
+
+func TestValidateStruct_ValidatableCollection(t *testing.T) {
+	type order struct {
+		Items    []Model3
+		Pointers []*Model3
+		Array    [2]Model3
+		Map      map[string]Model3
+		Context  []Model4
+	}
+	o := order{
+		Items:    []Model3{{A: "abc"}, {A: "xyz"}},
+		Pointers: []*Model3{nil, {A: "xyz"}},
+		Array:    [2]Model3{{A: "xyz"}, {A: "abc"}},
+		Map:      map[string]Model3{"a": {A: "abc"}, "b": {A: "xyz"}},
+		Context:  []Model4{{A: "xyz"}},
+	}
+	fields := func(o *order) []*FieldRules {
+		return []*FieldRules{Field(&o.Items), Field(&o.Pointers), Field(&o.Array), Field(&o.Map), Field(&o.Context)}
+	}
+
+	err := ValidateStruct(&o, fields(&o)...)
+	assertError(t, "Array: (0: (A: error abc.).); Context: (0: (A: error abc.).); Items: (1: (A: error abc.).); Map: (b: (A: error abc.).); Pointers: (1: (A: error abc.).).", err, "t1")
+	err = ValidateStructWithContext(context.Background(), &o, fields(&o)...)
+	assertError(t, "Array: (0: (A: error abc.).); Context: (0: (A: error abc.).); Items: (1: (A: error abc.).); Map: (b: (A: error abc.).); Pointers: (1: (A: error abc.).).", err, "t2")
+
+	// nil elements and collections are valid
+	o = order{Pointers: []*Model3{nil}, Array: [2]Model3{{A: "abc"}, {A: "abc"}}, Map: map[string]Model3{}}
+	assert.Nil(t, ValidateStruct(&o, fields(&o)...))
+	assert.Nil(t, Validate(map[string]*Model3{"a": nil}))
+}
+
+func TestValidateStruct_Abort(t *testing.T) {
+	type shape struct {
+		Kind   string
+		Radius int
+		Width  int
+	}
+	kind := By(func(value interface{}) error {
+		if value.(string) != "circle" {
+			return Abort(errors.New("must be a known kind"))
+		}
+		return nil
+	})
+	s0 := shape{Kind: "circle"}
+	s1 := shape{Kind: "triangle"}
+	tests := []struct {
+		tag   string
+		model *shape
+		rules func(s *shape) []*FieldRules
+		err   string
+	}{
+		{"t1", &s0, func(s *shape) []*FieldRules {
+			return []*FieldRules{Field(&s.Width, Required), Field(&s.Kind, kind), Field(&s.Radius, Required)}
+		}, "Radius: is required; Width: is required."},
+		{"t2", &s1, func(s *shape) []*FieldRules {
+			return []*FieldRules{Field(&s.Width, Required), Field(&s.Kind, kind), Field(&s.Radius, Required)}
+		}, "Kind: must be a known kind; Width: is required."},
+		{"t3", &s1, func(s *shape) []*FieldRules {
+			return []*FieldRules{Field(&s.Kind, By(func(interface{}) error { return ErrAbort })), Field(&s.Radius, Required)}
+		}, "Kind: validation aborted."},
+		{"t4", &s1, func(s *shape) []*FieldRules {
+			return []*FieldRules{Field(&s.Kind, WithContext(func(context.Context, interface{}) error {
+				return Abort(errors.New("aborted with context"))
+			})), Field(&s.Radius, Required)}
+		}, "Kind: aborted with context."},
+	}
+	for _, test := range tests {
+		err1 := ValidateStruct(test.model, test.rules(test.model)...)
+		err2 := ValidateStructWithContext(context.Background(), test.model, test.rules(test.model)...)
+		assertError(t, test.err, err1, test.tag)
+		assertError(t, test.err, err2, test.tag)
+	}
+
+	err := Abort(errors.New("abc"))
+	assert.True(t, errors.Is(err, ErrAbort))
+	assert.EqualError(t, err, "abc")
+	assert.EqualError(t, errors.Unwrap(err), "abc")
+}
+
+func TestFieldRules_SkipNil(t *testing.T) {
+	type patch struct {
+		Name  *string
+		Age   *int
+		Tags  []string
+		Title string
+	}
+	rules := func(p *patch) error {
+		return ValidateStruct(p,
+			Field(&p.Name, Required, Length(2, 10)).SkipNil(),
+			Field(&p.Age, Required, Min(18)).SkipNil(),
+			Field(&p.Tags, Required).SkipNil(),
+			Field(&p.Title, Required).SkipNil(),
+		)
+	}
+	empty, short, name := "", "a", "John"
+	zero, young, adult := 0, 16, 30
+
+	tests := []struct {
+		tag   string
+		model patch
+		err   string
+	}{
+		{"t1", patch{Title: "x"}, ""},
+		{"t2", patch{Name: &name, Age: &adult, Tags: []string{"a"}, Title: "x"}, ""},
+		{"t3", patch{Name: &empty, Age: &zero, Tags: []string{}, Title: "x"}, "Age: is required; Name: cannot be blank; Tags: cannot be empty."},
+		{"t4", patch{Name: &short, Age: &young, Title: "x"}, "Age: must be no less than 18; Name: the length must be between 2 and 10."},
+		{"t5", patch{}, "Title: cannot be blank."},
+	}
+
+	for _, test := range tests {
+		err := rules(&test.model)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// without SkipNil, a nil pointer is validated
+	p := patch{}
+	err := ValidateStruct(&p, Field(&p.Name, Required))
+	assertError(t, "Name: cannot be blank.", err, "t6")
+}
+
+func TestStructValidator_CollectWarnings(t *testing.T) {
+	type account struct {
+		Name     string
+		Password string
+		Tags     []string
+	}
+	validate := func(a *account, warnings *Errors) error {
+		return Struct(a,
+			Field(&a.Name, Required),
+			Field(&a.Password, Required, Warn(Length(12, 0).Error("should be at least 12 characters"))),
+			Field(&a.Tags, Warn(Each(Length(2, 0)))),
+		).CollectWarnings(warnings).Validate()
+	}
+
+	var warnings Errors
+	err := validate(&account{Name: "John", Password: "secret", Tags: []string{"go", "x"}}, &warnings)
+	assert.Nil(t, err)
+	assertError(t, "Password: should be at least 12 characters; Tags: (1: the length must be no less than 2.).", warnings, "t1")
+
+	err = validate(&account{Password: "secret"}, &warnings)
+	assertError(t, "Name: cannot be blank.", err, "t2")
+	assertError(t, "Password: should be at least 12 characters.", warnings, "t3")
+
+	err = validate(&account{Name: "John", Password: "a very long secret"}, &warnings)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+
+	// without CollectWarnings, warnings are dropped unless they are requested by the context
+	a := account{Password: "secret"}
+	assert.Nil(t, ValidateStruct(&a, Field(&a.Password, Warn(Length(12, 0)))))
+	err = ValidateStructWithContext(WithWarnings(context.Background()), &a, Field(&a.Password, Warn(Length(12, 0))))
+	assertError(t, "Password: the length must be no less than 12.", err, "t4")
+	assert.Equal(t, SeverityWarning, SeverityOf(err.(Errors)["Password"]))
+
+	// the errors keep their order when combined with InDeclarationOrder
+	a = account{Password: "secret", Tags: []string{"x"}}
+	err = Struct(&a,
+		Field(&a.Password, Warn(Length(12, 0))),
+		Field(&a.Tags, Warn(Length(2, 0))),
+		Field(&a.Name, Required),
+	).InDeclarationOrder().CollectWarnings(&warnings).Validate()
+	assertError(t, "Name: cannot be blank.", err, "t5")
+	assert.Equal(t, 2, warnings.Count())
+
+	// a warning does not stop the rules after it
+	validate = func(a *account, warnings *Errors) error {
+		return Struct(a,
+			Field(&a.Password, Warn(Length(12, 0)), In("zzz")),
+		).CollectWarnings(warnings).Validate()
+	}
+	err = validate(&account{Password: "secret"}, &warnings)
+	assertError(t, "Password: must be a valid value.", err, "t6")
+	assert.Empty(t, warnings)
+	err = validate(&account{Password: "zzz"}, &warnings)
+	assert.Nil(t, err)
+	assertError(t, "Password: the length must be no less than 12.", warnings, "t7")
+}
+
+// recordingObserver records the calls made to an Observer.
+type recordingObserver struct {
+	calls []string
+}
+
+func (o *recordingObserver) BeforeField(name string, value interface{}) {
+	o.calls = append(o.calls, fmt.Sprintf("before %v=%v", name, value))
+}
+
+func (o *recordingObserver) AfterField(name string, value interface{}, err error) {
+	o.calls = append(o.calls, fmt.Sprintf("after %v=%v: %v", name, value, err))
+}
+
+func TestStructValidator_WithObserver(t *testing.T) {
+	type user struct {
+		Name  string `json:"name"`
+		Email string
+		Phone *string
+	}
+	u := user{Name: "John", Email: "john"}
+	fields := []*FieldRules{
+		Field(&u.Name, Required),
+		Field(&u.Email, Required, Length(5, 0)),
+		Field(&u.Phone, Required).SkipNil(),
+	}
+
+	obs := &recordingObserver{}
+	err := Struct(&u, fields...).WithObserver(obs).Validate()
+	assertError(t, "Email: the length must be no less than 5.", err, "t1")
+	assert.Equal(t, []string{
+		"before name=John",
+		"after name=John: <nil>",
+		"before Email=john",
+		"after Email=john: the length must be no less than 5",
+	}, obs.calls)
+	assert.Equal(t, ValidateStruct(&u, fields...), err)
+
+	obs = &recordingObserver{}
+	err = Struct(&u, fields...).WithObserver(obs).ValidateWithContext(context.Background())
+	assertError(t, "Email: the length must be no less than 5.", err, "t2")
+	assert.Len(t, obs.calls, 4)
+
+	// an aborted validation only reports the fields validated
+	obs = &recordingObserver{}
+	err = Struct(&u,
+		Field(&u.Email, By(func(interface{}) error { return ErrAbort })),
+		Field(&u.Name, Required),
+	).WithObserver(obs).Validate()
+	assertError(t, "Email: validation aborted.", err, "t3")
+	assert.Equal(t, []string{"before Email=john", "after Email=john: validation aborted"}, obs.calls)
+}
+
+func TestStructValidator_FailFast(t *testing.T) {
+	type order struct {
+		ID       string
+		Customer string
+		Total    int
+	}
+	calls := 0
+	counted := By(func(interface{}) error {
+		calls++
+		return nil
+	})
+	o := order{Total: -1}
+	fields := func() []*FieldRules {
+		return []*FieldRules{
+			Field(&o.ID, counted, Required),
+			Field(&o.Customer, counted, Required),
+			Field(&o.Total, counted, Min(0)),
+		}
+	}
+
+	err := Struct(&o, fields()...).Validate()
+	assertError(t, "Customer: cannot be blank; ID: cannot be blank; Total: must be no less than 0.", err, "t1")
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	err = Struct(&o, fields()...).FailFast().Validate()
+	assertError(t, "ID: cannot be blank.", err, "t2")
+	assert.Equal(t, 1, calls)
+	if assert.IsType(t, FieldError{}, err) {
+		fe := err.(FieldError)
+		assert.Equal(t, "ID", fe.Field)
+		assert.Equal(t, "cannot be blank", fe.Message)
+		assert.Equal(t, ErrRequired, fe.Err)
+	}
+	var e Error
+	assert.True(t, errors.As(err, &e))
+
+	o.ID = "1"
+	err = Struct(&o, fields()...).FailFast().InDeclarationOrder().ValidateWithContext(context.Background())
+	assertError(t, "Customer: cannot be blank.", err, "t3")
+	assert.IsType(t, FieldError{}, err)
+
+	err = Struct(&o, fields()...).FailFast().AsSlice().Validate()
+	assert.Equal(t, FieldErrors{{Field: "Customer", Message: "cannot be blank", Err: ErrRequired}}, err)
+
+	o.Customer = "John"
+	o.Total = 10
+	assert.Nil(t, Struct(&o, fields()...).FailFast().Validate())
+
+	// a field with only warnings does not stop the validation, even if the warnings are returned
+	var warnings Errors
+	o = order{ID: "1"}
+	err = Struct(&o,
+		Field(&o.ID, Warn(Length(5, 0))),
+		Field(&o.Customer, Required),
+		Field(&o.Total, Required),
+	).FailFast().CollectWarnings(&warnings).Validate()
+	assertError(t, "Customer: cannot be blank.", err, "t4")
+	assertError(t, "ID: the length must be no less than 5.", warnings, "t5")
+	err = Struct(&o,
+		Field(&o.ID, Warn(Length(5, 0))),
+		Field(&o.Customer, Required),
+		Field(&o.Total, Required),
+	).FailFast().ValidateWithContext(WithWarnings(context.Background()))
+	assertError(t, "Customer: cannot be blank.", err, "t6")
+
+	// the first failing field of an embedded struct is reported
+	type base struct {
+		Code string
+		Name string
+	}
+	type item struct {
+		base
+		Price int
+	}
+	it := item{Price: -1}
+	err = Struct(&it,
+		Field(&it.base, By(func(interface{}) error {
+			return ValidateStructWithContext(WithWarnings(context.Background()), &it.base,
+				Field(&it.Code, Warn(Required)), Field(&it.Name, Required))
+		})),
+		Field(&it.Price, Min(0)),
+	).FailFast().Validate()
+	assertError(t, "Name: cannot be blank.", err, "t7")
+}
+
+func TestStructValidator_InDeclarationOrder(t *testing.T) {
+	m := Model2{}
+	fields := []*FieldRules{Field(&m.B, Required), Field(&m.M3), Field(&m.Model3)}
+
+	err := Struct(&m, fields...).Validate()
+	assertError(t, "A: error abc; B: cannot be blank; M3: (A: error abc.).", err, "t1")
+	_, ok := err.(Errors)
+	assert.True(t, ok)
+
+	err = Struct(&m, fields...).InDeclarationOrder().Validate()
+	assertError(t, "B: cannot be blank; M3: (A: error abc.); A: error abc.", err, "t2")
+	errs, ok := err.(OrderedErrors)
+	if assert.True(t, ok) {
+		assert.Equal(t, []string{"B", "M3", "A"}, errs.Keys())
+		assert.EqualError(t, errs.Errors["B"], "cannot be blank")
+		b, _ := errs.MarshalJSON()
+		assert.Equal(t, `{"B":"cannot be blank","M3":{"A":"error abc"},"A":"error abc"}`, string(b))
+	}
+
+	err = Struct(&m, fields...).InDeclarationOrder().ValidateWithContext(context.Background())
+	assertError(t, "B: cannot be blank; M3: (A: error abc.); A: error abc.", err, "t3")
+
+	m = Model2{B: "abc", M3: Model3{A: "abc"}, Model3: Model3{A: "abc"}}
+	assert.Nil(t, Struct(&m, fields...).InDeclarationOrder().Validate())
+	assert.EqualError(t, Struct(m).Validate(), ErrStructPointer.Error())
+}
+
+func TestStructValidator_ErrorTag(t *testing.T) {
+	type form struct {
+		Name  string `json:"name" form:"full_name,omitempty"`
+		Email string `json:"email" form:"-"`
+		Age   int    `form:",omitempty"`
+		Zip   string
+	}
+	f := form{}
+	fields := func(f *form) []*FieldRules {
+		return []*FieldRules{Field(&f.Name, Required), Field(&f.Email, Required), Field(&f.Age, Required), Field(&f.Zip, Required)}
+	}
+
+	err := Struct(&f, fields(&f)...).Validate()
+	assert.EqualError(t, err, "Age: is required; Zip: cannot be blank; email: cannot be blank; name: cannot be blank.")
+	err = Struct(&f, fields(&f)...).ErrorTag("form").Validate()
+	assert.EqualError(t, err, "Age: is required; Email: cannot be blank; Zip: cannot be blank; full_name: cannot be blank.")
+	err = Struct(&f, fields(&f)...).ErrorTag("").Validate()
+	assert.EqualError(t, err, "Age: is required; Email: cannot be blank; Name: cannot be blank; Zip: cannot be blank.")
+	err = Struct(&f, fields(&f)...).ErrorTag("form").InDeclarationOrder().ValidateWithContext(context.Background())
+	assert.Equal(t, []string{"full_name", "Email", "Age", "Zip"}, err.(OrderedErrors).Keys())
+}
+
 func TestValidateStructWithContext(t *testing.T) {
 	m1 := Model1{A: "abc", B: "xyz", c: "abc", G: "xyz"}
 	m2 := Model2{Model3: Model3{A: "internal"}}
@@ -206,3 +769,187 @@ func Test_getErrorFieldName(t *testing.T) {
 	assert.NotNil(t, jsonIgnoredField)
 	assert.Equal(t, "JSONIgnoredField", getErrorFieldName(jsonIgnoredField))
 }
+
+func TestStructValidator_ForGroups(t *testing.T) {
+	type user struct {
+		ID    string
+		Name  string
+		Role  string
+		Email string
+	}
+	u := user{Role: "root"}
+	fields := func(u *user) []*FieldRules {
+		return []*FieldRules{
+			Field(&u.Name, Required),
+			Field(&u.ID, Empty).Groups("create"),
+			Field(&u.ID, Required).Groups("update"),
+			Field(&u.Role, In("admin", "root")).Groups("admin"),
+			Field(&u.Role, In("user")).Groups("create", "update"),
+			Field(&u.Email, Required).Groups("create").Groups("invite"),
+		}
+	}
+
+	// only the fields without groups are validated when no group is active
+	err := Struct(&u, fields(&u)...).Validate()
+	assertError(t, "Name: cannot be blank.", err, "t1")
+	assertError(t, "Name: cannot be blank.", ValidateStruct(&u, fields(&u)...), "t2")
+
+	u.Name = "John"
+	err = Struct(&u, fields(&u)...).ForGroups("create").Validate()
+	assertError(t, "Email: cannot be blank; Role: must be a valid value.", err, "t3")
+	err = Struct(&u, fields(&u)...).ForGroups("update").ValidateWithContext(context.Background())
+	assertError(t, "ID: cannot be blank; Role: must be a valid value.", err, "t4")
+	assert.Nil(t, Struct(&u, fields(&u)...).ForGroups("admin").Validate())
+	err = Struct(&u, fields(&u)...).ForGroups("admin", "invite").Validate()
+	assertError(t, "Email: cannot be blank.", err, "t5")
+	assert.Nil(t, Struct(&u, fields(&u)...).ForGroups("unknown").Validate())
+
+	// the fields outside of the active groups are not reported to the observer
+	obs := &recordingObserver{}
+	err = Struct(&u, fields(&u)...).ForGroups("invite").WithObserver(obs).Validate()
+	assertError(t, "Email: cannot be blank.", err, "t6")
+	assert.Equal(t, []string{"before Name=John", "after Name=John: <nil>", "before Email=", "after Email=: cannot be blank"}, obs.calls)
+}
+
+func TestFieldRules_OnlyIf(t *testing.T) {
+	type item struct {
+		Taxable bool
+		Tax     float64
+		Step    int
+		Note    string
+		Address ptrValidatable
+	}
+	type stepKey struct{}
+	i := item{Address: ptrValidatable{A: "xyz"}}
+	fields := func(i *item) []*FieldRules {
+		return []*FieldRules{
+			Field(&i.Tax, Required).OnlyIf(func() bool { return i.Taxable }),
+			Field(&i.Note, Required).OnlyIfContext(func(ctx context.Context) bool { return ctx.Value(stepKey{}) == 2 }),
+			Field(&i.Address).OnlyIf(func() bool { return i.Step > 0 }),
+		}
+	}
+
+	step1 := context.WithValue(context.Background(), stepKey{}, 1)
+	step2 := context.WithValue(context.Background(), stepKey{}, 2)
+
+	// a field whose predicate is false is not validated at all, including its own Validate() method
+	assert.Nil(t, ValidateStructWithContext(step1, &i, fields(&i)...))
+	i.Taxable = true
+	assertError(t, "Tax: is required.", ValidateStructWithContext(step1, &i, fields(&i)...), "t1")
+	i.Step = 1
+	assertError(t, "Address: (A: error abc.); Tax: is required.", ValidateStructWithContext(step1, &i, fields(&i)...), "t2")
+	i.Tax = 0.2
+
+	// the predicate of OnlyIfContext is only called with a context
+	assertError(t, "Address: (A: error abc.); Note: cannot be blank.", ValidateStructWithContext(step2, &i, fields(&i)...), "t3")
+	assertError(t, "Address: (A: error abc.); Note: cannot be blank.", ValidateStruct(&i, fields(&i)...), "t4")
+
+	// both predicates must be true, and the last OnlyIf wins
+	called := 0
+	rule := Field(&i.Note, Required).
+		OnlyIf(func() bool { return false }).
+		OnlyIf(func() bool { called++; return true }).
+		OnlyIfContext(func(ctx context.Context) bool { return false })
+	assert.Nil(t, ValidateStructWithContext(context.Background(), &i, rule))
+	assertError(t, "Note: cannot be blank.", ValidateStruct(&i, rule), "t6")
+	assert.Equal(t, 2, called)
+
+	// the skipped fields are not reported to the observer
+	obs := &recordingObserver{}
+	i.Taxable, i.Step = false, 0
+	assert.Nil(t, Struct(&i, fields(&i)...).WithObserver(obs).ValidateWithContext(step1))
+	assert.Empty(t, obs.calls)
+}
+
+func TestStructValidator_AsSlice(t *testing.T) {
+	type address struct {
+		Street string
+		Zip    string
+	}
+	type form struct {
+		Name    string `json:"name"`
+		Email   string
+		Address address
+		Tags    []string
+	}
+	f := form{Email: "x", Tags: []string{"a", ""}}
+	fields := func(f *form) []*FieldRules {
+		addressRules := By(func(value interface{}) error {
+			a := value.(address)
+			return Struct(&a, Field(&a.Zip, Required), Field(&a.Street, Required)).InDeclarationOrder().Validate()
+		})
+		return []*FieldRules{
+			Field(&f.Name, Required),
+			Field(&f.Email, Length(3, 0)),
+			Field(&f.Email, Required, Match(regexp.MustCompile("@"))),
+			Field(&f.Address, addressRules),
+			Field(&f.Tags, Each(Required)),
+		}
+	}
+
+	err := Struct(&f, fields(&f)...).AsSlice().Validate()
+	if assert.IsType(t, FieldErrors{}, err) {
+		assert.Equal(t, []string{"name", "Email", "Email", "Address.Zip", "Address.Street", "Tags.1"}, fieldPaths(err.(FieldErrors)))
+		assert.Equal(t, FieldError{Field: "name", Message: "cannot be blank", Err: ErrRequired}, err.(FieldErrors)[0])
+	}
+	assertError(t, "name: cannot be blank; Email: the length must be no less than 3; Email: must be in a valid format; "+
+		"Address.Zip: cannot be blank; Address.Street: cannot be blank; Tags.1: cannot be blank.", err, "t1")
+
+	// the map form remains the default
+	err = Struct(&f, fields(&f)...).Validate()
+	assert.IsType(t, Errors{}, err)
+
+	// the warnings are not listed if they are collected separately
+	var warnings Errors
+	f = form{Name: "John", Email: "a@b", Address: address{"Main St", "12345"}}
+	warned := []*FieldRules{Field(&f.Name, Warn(Length(5, 0))), Field(&f.Email, Required)}
+	assert.Nil(t, Struct(&f, warned...).AsSlice().CollectWarnings(&warnings).Validate())
+	assertError(t, "name: the length must be no less than 5.", warnings, "t2")
+	assert.Nil(t, Struct(&f, warned...).AsSlice().Validate())
+	err = Struct(&f, warned...).AsSlice().ValidateWithContext(WithWarnings(context.Background()))
+	assertError(t, "name: the length must be no less than 5.", err, "t3")
+	assert.Nil(t, Struct(&f, fields(&f)...).AsSlice().ValidateWithContext(context.Background()))
+}
+
+func fieldPaths(es FieldErrors) []string {
+	paths := make([]string, len(es))
+	for i, e := range es {
+		paths[i] = e.Field
+	}
+	return paths
+}
+
+func TestValidateStruct_PointerReceiver(t *testing.T) {
+	s := struct {
+		P     ptrValidatable
+		C     ptrContextValidatable
+		PP    *ptrValidatable
+		Items []ptrValidatable
+	}{
+		P:     ptrValidatable{A: "xyz"},
+		C:     ptrContextValidatable{A: "xyz"},
+		PP:    &ptrValidatable{A: "xyz"},
+		Items: []ptrValidatable{{A: "abc"}, {A: "xyz"}},
+	}
+
+	err := ValidateStruct(&s, Field(&s.P), Field(&s.C), Field(&s.PP), Field(&s.Items))
+	assertError(t, "C: (A: error abc.); Items: (1: (A: error abc.).); P: (A: error abc.); PP: (A: error abc.).", err, "t1")
+	err = ValidateStructWithContext(context.Background(), &s, Field(&s.P), Field(&s.C), Field(&s.PP), Field(&s.Items))
+	assertError(t, "C: (A: error abc.); Items: (1: (A: error abc.).); P: (A: error abc.); PP: (A: error abc.).", err, "t2")
+
+	// the rules are given the field value and applied before the nested validation, and Skip still skips it
+	err = ValidateStruct(&s, Field(&s.P, Required, By(func(value interface{}) error {
+		if value.(ptrValidatable).A == "xyz" {
+			return errors.New("must not be xyz")
+		}
+		return nil
+	})))
+	assertError(t, "P: must not be xyz.", err, "t3")
+	assert.Nil(t, ValidateStruct(&s, Field(&s.P, Skip)))
+	assertError(t, "P: (A: error abc.).", ValidateStruct(&s, Field(&s.P, In(ptrValidatable{A: "xyz"}))), "t4")
+	assertError(t, "P: (A: error abc.).", ValidateStruct(&s, Field(&s.P, Warn(In("zzz")))), "t5")
+	assertError(t, "P: must be a valid value.", ValidateStructWithContext(context.Background(), &s, Field(&s.P, In("zzz"))), "t6")
+
+	s.P.A, s.C.A, s.PP.A, s.Items[1].A = "abc", "abc", "abc", "abc"
+	assert.Nil(t, ValidateStruct(&s, Field(&s.P), Field(&s.C), Field(&s.PP), Field(&s.Items)))
+}
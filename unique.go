@@ -0,0 +1,93 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrUniqueInvalid is the error that returns when an iterable contains duplicate elements.
+var ErrUniqueInvalid = NewError("validation_unique_invalid", "must not contain duplicates ({{.value}} is repeated at index {{.index}})")
+
+// Unique returns a validation rule that checks if a slice or an array does not contain duplicate elements.
+// Pointer elements are compared by the values they point to. Comparable elements are checked using a set,
+// while the others are compared with reflect.DeepEqual().
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Unique() UniqueRule {
+	return UniqueRule{err: ErrUniqueInvalid}
+}
+
+// UniqueBy returns a validation rule that checks if a slice or an array does not contain elements
+// sharing the same key. The key of each element is derived by calling the given function,
+// which is useful for checking the uniqueness of a field among a slice of structs.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func UniqueBy(key func(elem interface{}) interface{}) UniqueRule {
+	return UniqueRule{key: key, err: ErrUniqueInvalid}
+}
+
+// UniqueRule is a validation rule that checks if the elements of a slice or an array are unique.
+type UniqueRule struct {
+	key func(elem interface{}) interface{}
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r UniqueRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return errors.New("must be a slice or an array")
+	}
+
+	seen := make(map[interface{}]bool, v.Len())
+	var others []interface{}
+	for i := 0; i < v.Len(); i++ {
+		k := r.getKey(v.Index(i))
+		duplicated := false
+		// the dynamic value is checked, as a struct or an array of a comparable type may hold a slice in an interface
+		if k == nil || reflect.ValueOf(k).Comparable() {
+			duplicated = seen[k]
+			seen[k] = true
+		} else {
+			for _, o := range others {
+				if reflect.DeepEqual(o, k) {
+					duplicated = true
+					break
+				}
+			}
+			others = append(others, k)
+		}
+		if duplicated {
+			return r.err.SetParams(map[string]interface{}{"value": k, "index": i})
+		}
+	}
+
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r UniqueRule) Error(message string) UniqueRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r UniqueRule) ErrorObject(err Error) UniqueRule {
+	r.err = err
+	return r
+}
+
+func (r UniqueRule) getKey(elem reflect.Value) interface{} {
+	if r.key != nil {
+		return r.key(elem.Interface())
+	}
+	k, _ := Indirect(elem.Interface())
+	return k
+}
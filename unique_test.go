@@ -0,0 +1,76 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package valid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	a, b, c := "a", "b", "a"
+	var s0 []string
+	type box struct{ V interface{} }
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nil, ""},
+		{"t2", s0, ""},
+		{"t3", []string{}, ""},
+		{"t4", []string{"a", "b", "c"}, ""},
+		{"t5", []string{"a", "b", "a", "b"}, "must not contain duplicates (a is repeated at index 2)"},
+		{"t6", &[]string{"a", "a"}, "must not contain duplicates (a is repeated at index 1)"},
+		{"t7", [3]int{1, 2, 2}, "must not contain duplicates (2 is repeated at index 2)"},
+		{"t8", []*string{&a, &b}, ""},
+		{"t9", []*string{&a, &b, &c}, "must not contain duplicates (a is repeated at index 2)"},
+		{"t10", [][]int{{1}, {2}}, ""},
+		{"t11", [][]int{{1}, {2}, {1}}, "must not contain duplicates ([1] is repeated at index 2)"},
+		{"t12", []interface{}{1, "1", nil, nil}, "must not contain duplicates (<no value> is repeated at index 3)"},
+		{"t13", "abc", "must be a slice or an array"},
+		// structs and arrays holding unhashable values in interfaces are compared with reflect.DeepEqual
+		{"t14", []box{{[]int{1}}, {[]int{2}}, {1}}, ""},
+		{"t15", []box{{[]int{1}}, {1}, {[]int{1}}}, "must not contain duplicates ({[1]} is repeated at index 2)"},
+		{"t16", []box{{1}, {[]int{1}}, {1}}, "must not contain duplicates ({1} is repeated at index 2)"},
+		{"t17", [][1]interface{}{{map[string]int{"a": 1}}, {map[string]int{"a": 1}}}, "must not contain duplicates ([map[a:1]] is repeated at index 1)"},
+	}
+
+	for _, test := range tests {
+		err := Unique().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	type invitee struct {
+		Name  string
+		Email string
+	}
+	rule := UniqueBy(func(elem interface{}) interface{} {
+		return elem.(invitee).Email
+	})
+
+	err := rule.Validate([]invitee{{"A", "a@example.com"}, {"B", "b@example.com"}})
+	assert.Nil(t, err)
+	err = rule.Validate([]invitee{{"A", "a@example.com"}, {"B", "b@example.com"}, {"C", "a@example.com"}})
+	assertError(t, "must not contain duplicates (a@example.com is repeated at index 2)", err, "t2")
+}
+
+func TestUniqueRule_Error(t *testing.T) {
+	r := Unique()
+	assert.Equal(t, "must not contain duplicates ({{.value}} is repeated at index {{.index}})", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestUniqueRule_ErrorObject(t *testing.T) {
+	r := Unique()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, "abc", r.Validate([]int{1, 1}).Error())
+}
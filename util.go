@@ -5,52 +5,116 @@
 package valid
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"time"
 )
 
 var (
-	bytesType  = reflect.TypeOf([]byte(nil))
-	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	bytesType      = reflect.TypeOf([]byte(nil))
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	valuerType     = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigRatType   = reflect.TypeOf(big.Rat{})
+	bigFloatType = reflect.TypeOf(big.Float{})
 )
 
 // EnsureString ensures the given value is a string.
-// If the value is a byte slice, it will be typecast into a string.
-// An error is returned otherwise.
+// A value of a string kind (e.g. a defined type such as `type Email string`) is returned as a plain string.
+// Otherwise, the value is converted by String() if it implements fmt.Stringer, or by MarshalText() if it implements
+// encoding.TextMarshaler, in this order. A value implementing both is thus converted by String(), e.g. a time.Time
+// gives "2006-01-02 15:04:05 +0000 UTC" rather than its RFC 3339 text. A byte slice, including one of a defined type
+// (e.g. type Blob []byte), is typecast into a string unless one of these methods applies (e.g. net.IP).
+// An error is returned otherwise, or if MarshalText() fails.
 func EnsureString(value interface{}) (string, error) {
 	v := reflect.ValueOf(value)
-	if v.Kind() == reflect.String {
-		return v.String(), nil
-	}
-	if v.Type() == bytesType {
+	if v.Kind() == reflect.Slice && v.Type() == bytesType {
 		return string(v.Interface().([]byte)), nil
 	}
+	if str, ok, err := stringOf(value); ok {
+		return str, err
+	}
+	if isByteSlice(v) {
+		return string(v.Bytes()), nil
+	}
 	return "", errors.New("must be either a string or byte slice")
 }
 
 // StringOrBytes typecasts a value into a string or byte slice.
-// Boolean flags are returned to indicate if the typecasting succeeds or not.
+// If the value is neither of them, it is converted into a string like EnsureString does, i.e. by String() or
+// MarshalText(). A byte slice of a defined type (e.g. type Blob []byte) is returned as a []byte unless it can be
+// converted this way. A json.RawMessage is always returned as a []byte, even though it implements fmt.Stringer.
+// Boolean flags are returned to indicate if the typecasting succeeds or not. If MarshalText() fails, the value is
+// reported as neither a string nor a byte slice; use EnsureString to get the error.
 func StringOrBytes(value interface{}) (isString bool, str string, isBytes bool, bs []byte) {
 	v := reflect.ValueOf(value)
-	if v.Kind() == reflect.String {
-		str = v.String()
-		isString = true
-	} else if v.Kind() == reflect.Slice && v.Type() == bytesType {
+	if v.Kind() == reflect.Slice && v.Type() == bytesType {
 		bs = v.Interface().([]byte)
 		isBytes = true
+	} else if v.Kind() == reflect.Slice && v.Type() == rawMessageType {
+		bs = v.Bytes()
+		isBytes = true
+	} else if text, ok, err := stringOf(value); ok && err == nil {
+		str = text
+		isString = true
+	} else if !ok && isByteSlice(v) {
+		bs = v.Bytes()
+		isBytes = true
 	}
 	return
 }
 
-// LengthOfValue returns the length of a value that is a string, slice, map, or array.
+// stringOf returns the string form of a value, which is the value itself for a string kind, or the result of
+// String() or MarshalText() if the value implements fmt.Stringer or encoding.TextMarshaler, respectively, with
+// String() taking precedence for a value implementing both (e.g. time.Time).
+// It is the single place where the string rules extract the string to check.
+// The boolean result is false if the value has no string form.
+func stringOf(value interface{}) (string, bool, error) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+		return v.String(), true, nil
+	}
+	switch m := value.(type) {
+	case fmt.Stringer:
+		return m.String(), true, nil
+	case encoding.TextMarshaler:
+		text, err := m.MarshalText()
+		return string(text), true, err
+	}
+	return "", false, nil
+}
+
+// isByteSlice reports whether the value is a slice of bytes, which may be of a defined type.
+func isByteSlice(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8
+}
+
+// trimSpace returns a string or byte slice value with the leading and trailing white space removed.
+// A string of a defined type is returned as a plain string. Any other value is returned as is.
+func trimSpace(value interface{}) interface{} {
+	switch isString, str, isBytes, bs := StringOrBytes(value); {
+	case isString:
+		return strings.TrimSpace(str)
+	case isBytes:
+		return bytes.TrimSpace(bs)
+	}
+	return value
+}
+
+// LengthOfValue returns the length of a value that is a string, slice, map, array, or channel.
+// The length of a channel is the number of elements queued in its buffer.
 // An error is returned for all other types.
 func LengthOfValue(value interface{}) (int, error) {
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
-	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
 		return v.Len(), nil
 	}
 	return 0, fmt.Errorf("cannot get the length of %v", v.Kind())
@@ -89,14 +153,186 @@ func ToFloat(value interface{}) (float64, error) {
 	return 0, fmt.Errorf("cannot convert %v to float64", v.Kind())
 }
 
+// compareNumbers compares two numbers of any int, uint or float kinds and returns -1, 0 or 1 if x is less than,
+// equal to or greater than y. Integers are compared exactly, even between int64 and uint64 values beyond the range
+// of the other type. If either number is a float, both are compared as float64, which may lose precision for
+// integers beyond 2^53. If either number is a math/big number (see isBigNumber), both are compared exactly with
+// big.Rat.Cmp. The boolean result is false if either value is not a number or is NaN.
+func compareNumbers(x, y reflect.Value) (int, bool) {
+	if isBigNumber(x) || isBigNumber(y) {
+		a, ok := toBigRat(x)
+		if !ok {
+			return 0, false
+		}
+		b, ok := toBigRat(y)
+		if !ok {
+			return 0, false
+		}
+		return a.Cmp(b), true
+	}
+	xk, yk := numberKind(x.Kind()), numberKind(y.Kind())
+	switch {
+	case xk == reflect.Invalid || yk == reflect.Invalid:
+		return 0, false
+	case xk == reflect.Float64 || yk == reflect.Float64:
+		a, b := numberToFloat(x), numberToFloat(y)
+		if a != a || b != b {
+			return 0, false
+		}
+		return compareOrdered(a < b, a > b), true
+	case xk == reflect.Int64 && yk == reflect.Int64:
+		return compareOrdered(x.Int() < y.Int(), x.Int() > y.Int()), true
+	case xk == reflect.Uint64 && yk == reflect.Uint64:
+		return compareOrdered(x.Uint() < y.Uint(), x.Uint() > y.Uint()), true
+	case xk == reflect.Int64:
+		if x.Int() < 0 {
+			return -1, true
+		}
+		return compareOrdered(uint64(x.Int()) < y.Uint(), uint64(x.Int()) > y.Uint()), true
+	default:
+		if y.Int() < 0 {
+			return 1, true
+		}
+		return compareOrdered(x.Uint() < uint64(y.Int()), x.Uint() > uint64(y.Int())), true
+	}
+}
+
+// numbersEqual checks if two values are numbers of predeclared int, uint or float types (e.g. int32 and int)
+// or math/big numbers that are equal to each other. Numbers of defined types (e.g. enum types) are not considered,
+// so that they are only equal to values of the same type.
+func numbersEqual(x, y interface{}) bool {
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if !xv.IsValid() || !yv.IsValid() {
+		return false
+	}
+	if xv.Type().PkgPath() != "" && !isBigNumber(xv) || yv.Type().PkgPath() != "" && !isBigNumber(yv) {
+		return false
+	}
+	c, ok := compareNumbers(xv, yv)
+	return ok && c == 0
+}
+
+// numberKind returns Int64, Uint64 or Float64 for the int, uint and float kinds respectively, and Invalid otherwise.
+func numberKind(k reflect.Kind) reflect.Kind {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+	return reflect.Invalid
+}
+
+// isNumber checks if a value is a number of any int, uint or float kind, or a math/big number.
+func isNumber(v reflect.Value) bool {
+	return numberKind(v.Kind()) != reflect.Invalid || isBigNumber(v)
+}
+
+// isBigNumber checks if a value is a big.Int, big.Rat or big.Float, or a pointer to one of them.
+func isBigNumber(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	t := v.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == bigIntType || t == bigRatType || t == bigFloatType
+}
+
+// toBigRat returns the exact value of a number of any int, uint or float kind, or of a math/big number, as a big.Rat.
+// The boolean result is false if the value is not a number, is a nil pointer, or is a NaN or infinite float.
+func toBigRat(v reflect.Value) (*big.Rat, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	switch x := v.Interface().(type) {
+	case big.Int:
+		return new(big.Rat).SetInt(&x), true
+	case big.Rat:
+		return new(big.Rat).Set(&x), true
+	case big.Float:
+		r, _ := x.Rat(nil)
+		return r, r != nil
+	}
+	switch numberKind(v.Kind()) {
+	case reflect.Int64:
+		return new(big.Rat).SetInt64(v.Int()), true
+	case reflect.Uint64:
+		return new(big.Rat).SetUint64(v.Uint()), true
+	case reflect.Float64:
+		r := new(big.Rat).SetFloat64(v.Float())
+		return r, r != nil
+	}
+	return nil, false
+}
+
+// toBigInt returns the value of a number of any int or uint kind, or of a big.Int, as a big.Int.
+// The boolean result is false if the value is not such a number or is a nil pointer.
+func toBigInt(v reflect.Value) (*big.Int, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if x, ok := v.Interface().(big.Int); ok {
+		return new(big.Int).Set(&x), true
+	}
+	switch numberKind(v.Kind()) {
+	case reflect.Int64:
+		return big.NewInt(v.Int()), true
+	case reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()), true
+	}
+	return nil, false
+}
+
+func numberToFloat(v reflect.Value) float64 {
+	switch numberKind(v.Kind()) {
+	case reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint64:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
 // IsEmpty checks if a value is empty or not.
 // A value is considered empty if
 // - integer, float: zero
 // - bool: false
 // - string, array: len() == 0
 // - slice, map: nil or len() == 0
-// - interface, pointer: nil or the referenced value is empty
+// - interface, pointer: nil or the referenced value is empty, through any number of pointer levels
+// - channel, function: nil (a non-nil channel is not empty even if nothing is queued in it)
+// - big.Int, big.Rat, big.Float: zero
 func IsEmpty(value interface{}) bool {
+	return isEmpty(value, 0)
+}
+
+func isEmpty(value interface{}, depth int) bool {
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
 	case reflect.String, reflect.Array, reflect.Map, reflect.Slice:
@@ -111,15 +347,26 @@ func IsEmpty(value interface{}) bool {
 		return v.Float() == 0
 	case reflect.Invalid:
 		return true
+	case reflect.Chan, reflect.Func:
+		return v.IsNil()
 	case reflect.Interface, reflect.Ptr:
 		if v.IsNil() {
 			return true
 		}
-		return IsEmpty(v.Elem().Interface())
+		if depth >= maxIndirectDepth {
+			return false
+		}
+		return isEmpty(v.Elem().Interface(), depth+1)
 	case reflect.Struct:
-		v, ok := value.(time.Time)
-		if ok && v.IsZero() {
-			return true
+		switch v := value.(type) {
+		case time.Time:
+			return v.IsZero()
+		case big.Int:
+			return v.Sign() == 0
+		case big.Rat:
+			return v.Sign() == 0
+		case big.Float:
+			return v.Sign() == 0
 		}
 	}
 
@@ -131,7 +378,17 @@ func IsEmpty(value interface{}) bool {
 // the Value() method instead. A boolean value is also returned to indicate if
 // the value is nil or not (only applicable to interface, pointer, map, and slice).
 // If the value is neither an interface nor a pointer, it will be returned back.
+// Multiple levels of pointers (e.g. **string) are dereferenced until the ultimate value is reached, and a nil
+// pointer at any level makes the value nil. To guard against cyclic pointers, at most maxIndirectDepth levels
+// are dereferenced, after which the value reached is returned as is.
 func Indirect(value interface{}) (interface{}, bool) {
+	return indirect(value, 0)
+}
+
+// maxIndirectDepth is the maximum number of pointer or interface levels dereferenced by Indirect and IsEmpty.
+const maxIndirectDepth = 32
+
+func indirect(value interface{}, depth int) (interface{}, bool) {
 	rv := reflect.ValueOf(value)
 	kind := rv.Kind()
 	switch kind {
@@ -141,7 +398,10 @@ func Indirect(value interface{}) (interface{}, bool) {
 		if rv.IsNil() {
 			return nil, true
 		}
-		return Indirect(rv.Elem().Interface())
+		if depth >= maxIndirectDepth {
+			return value, false
+		}
+		return indirect(rv.Elem().Interface(), depth+1)
 	case reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
 		if rv.IsNil() {
 			return nil, true
@@ -6,6 +6,12 @@ package valid
 
 import (
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"net"
+	"reflect"
 	"testing"
 	"time"
 
@@ -27,6 +33,19 @@ func TestEnsureString(t *testing.T) {
 		{"t3", bytes, "abc", false},
 		{"t4", &bytes, "", true},
 		{"t5", 100, "", true},
+		{"t6", net.ParseIP("10.0.0.1"), "10.0.0.1", false},
+		{"t7", textValue{text: "abc"}, "abc", false},
+		{"t8", textValue{err: errors.New("abc")}, "", true},
+		{"t9", json.RawMessage("abc"), "abc", false},
+		{"t10", MyBytes("abc"), "abc", false},
+		{"t11", []int8{1}, "", true},
+		{"t12", MyString("abc"), "abc", false},
+		{"t13", statusActive, "Active", false},
+		{"t14", stringText{"abc"}, "string abc", false},
+		{"t15", hexBytes("abc"), "616263", false},
+		{"t16", regionID(3), "region-3", false},
+		// String() takes precedence over MarshalText()
+		{"t17", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "2006-01-02 15:04:05 +0000 UTC", false},
 	}
 	for _, test := range tests {
 		s, err := EnsureString(test.value)
@@ -41,6 +60,36 @@ func TestEnsureString(t *testing.T) {
 
 type MyString string
 
+type MyBytes []byte
+
+type hexBytes []byte
+
+func (b hexBytes) String() string {
+	return hex.EncodeToString(b)
+}
+
+// stringText implements both fmt.Stringer and encoding.TextMarshaler.
+type stringText struct {
+	s string
+}
+
+func (v stringText) String() string {
+	return "string " + v.s
+}
+
+func (v stringText) MarshalText() ([]byte, error) {
+	return []byte("text " + v.s), nil
+}
+
+type textValue struct {
+	text string
+	err  error
+}
+
+func (v textValue) MarshalText() ([]byte, error) {
+	return []byte(v.text), v.err
+}
+
 func TestStringOrBytes(t *testing.T) {
 	str := "abc"
 	bytes := []byte("abc")
@@ -69,6 +118,15 @@ func TestStringOrBytes(t *testing.T) {
 		{"t10", str3, "abc", nil, true, false},
 		{"t11", &str3, "", nil, false, false},
 		{"t12", str4, "", nil, false, false},
+		{"t13", net.ParseIP("10.0.0.1"), "10.0.0.1", nil, true, false},
+		{"t14", textValue{err: errors.New("abc")}, "", nil, false, false},
+		{"t15", json.RawMessage("abc"), "", []byte("abc"), false, true},
+		{"t16", MyBytes("abc"), "", []byte("abc"), false, true},
+		{"t17", statusInactive, "Inactive", nil, true, false},
+		{"t18", stringText{"abc"}, "string abc", nil, true, false},
+		{"t19", hexBytes("abc"), "616263", nil, true, false},
+		{"t20", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), "2006-01-02 15:04:05 +0000 UTC", nil, true, false},
+		{"t21", textValue{text: "abc", err: errors.New("abc")}, "", nil, false, false},
 	}
 	for _, test := range tests {
 		isString, str, isBytes, bs := StringOrBytes(test.value)
@@ -81,6 +139,9 @@ func TestStringOrBytes(t *testing.T) {
 
 func TestLengthOfValue(t *testing.T) {
 	var a [3]int
+	buffered := make(chan int, 3)
+	buffered <- 1
+	buffered <- 2
 
 	tests := []struct {
 		tag    string
@@ -94,6 +155,9 @@ func TestLengthOfValue(t *testing.T) {
 		{"t4", a, 3, ""},
 		{"t5", &a, 0, "cannot get the length of ptr"},
 		{"t6", 123, 0, "cannot get the length of int"},
+		{"t7", make(chan int, 3), 0, ""},
+		{"t8", buffered, 2, ""},
+		{"t9", (chan int)(nil), 0, ""},
 	}
 
 	for _, test := range tests {
@@ -191,6 +255,34 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+func TestCompareNumbers(t *testing.T) {
+	tests := []struct {
+		tag  string
+		x, y interface{}
+		c    int
+		ok   bool
+	}{
+		{"t1", int8(1), int64(2), -1, true},
+		{"t2", uint16(2), uint64(1), 1, true},
+		{"t3", int32(-1), uint(0), -1, true},
+		{"t4", uint(0), int32(-1), 1, true},
+		{"t5", int64(math.MaxInt64), uint64(math.MaxInt64) + 1, -1, true},
+		{"t6", uint64(math.MaxUint64), int64(math.MaxInt64), 1, true},
+		{"t7", uint32(3), int(3), 0, true},
+		{"t8", float32(1.5), int(1), 1, true},
+		{"t9", uint8(1), 1.0, 0, true},
+		{"t10", math.NaN(), 1, 0, false},
+		{"t11", "1", 1, 0, false},
+		{"t12", 1, nil, 0, false},
+	}
+
+	for _, test := range tests {
+		c, ok := compareNumbers(reflect.ValueOf(test.x), reflect.ValueOf(test.y))
+		assert.Equal(t, test.c, c, test.tag)
+		assert.Equal(t, test.ok, ok, test.tag)
+	}
+}
+
 func TestIsEmpty(t *testing.T) {
 	var s1 string
 	var s2 = "a"
@@ -258,6 +350,11 @@ func TestIsEmpty(t *testing.T) {
 		{"t10.2", &time1, false},
 		{"t10.3", time2, true},
 		{"t10.4", &time2, true},
+		// channel, func
+		{"t11.1", (chan int)(nil), true},
+		{"t11.2", make(chan int), false},
+		{"t11.3", (func())(nil), true},
+		{"t11.4", func() {}, false},
 	}
 
 	for _, test := range tests {
@@ -270,6 +367,9 @@ func TestIndirect(t *testing.T) {
 	var a = 100
 	var b *int
 	var c *sql.NullInt64
+	var pa = &a
+	var pb = b
+	var pp **int
 
 	tests := []struct {
 		tag    string
@@ -290,6 +390,9 @@ func TestIndirect(t *testing.T) {
 		{"t11", &sql.NullInt64{Int64: 0, Valid: true}, int64(0), false},
 		{"t12", &sql.NullInt64{Int64: 1, Valid: true}, int64(1), false},
 		{"t13", c, nil, true},
+		{"t14", &pa, 100, false},
+		{"t15", &pb, nil, true},
+		{"t16", pp, nil, true},
 	}
 
 	for _, test := range tests {
@@ -298,3 +401,14 @@ func TestIndirect(t *testing.T) {
 		assert.Equal(t, test.isNil, isNil, test.tag)
 	}
 }
+
+type cyclicPtr *cyclicPtr
+
+func TestIndirect_Cyclic(t *testing.T) {
+	var p cyclicPtr
+	p = &p
+	result, isNil := Indirect(p)
+	assert.NotNil(t, result)
+	assert.False(t, isNil)
+	assert.False(t, IsEmpty(p))
+}
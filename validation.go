@@ -7,9 +7,12 @@ package valid
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 	"strconv"
+	"sync/atomic"
 )
 
 type (
@@ -38,15 +41,32 @@ type (
 	}
 
 	// RuleFunc represents a validator function.
-	// You may wrap it as a Rule by calling By().
+	// RuleFunc implements Rule, so a function can be used as a rule by converting it to RuleFunc,
+	// e.g. valid.RuleFunc(checkName), or equivalently by calling By(). Go does not convert a function to an interface
+	// implicitly, so a function literal or a function not declared as RuleFunc must still be converted this way
+	// before it is passed as a Rule.
 	RuleFunc func(value interface{}) error
 
 	// RuleWithContextFunc represents a validator function that is context-aware.
-	// You may wrap it as a Rule by calling WithContext().
+	// RuleWithContextFunc implements RuleWithContext, so a function can be used as a context-aware rule by
+	// converting it to RuleWithContextFunc, or equivalently by calling WithContext().
 	RuleWithContextFunc func(ctx context.Context, value interface{}) error
 )
 
 var (
+	// ErrValueNotInterface is the error that a reflect.Value being validated cannot be used as an interface{}.
+	ErrValueNotInterface = errors.New("cannot validate a value obtained from an unexported struct field")
+
+	// ErrRecursionTooDeep is the error that the validatable values are nested deeper than MaxDepth.
+	ErrRecursionTooDeep = errors.New("validation recursion too deep")
+
+	// MaxDepth is the maximum number of nested ValidateWithContext() calls of validatable values made by
+	// ValidateWithContext, and likewise of nested Validate() calls of values that only implement Validatable.
+	// When it is exceeded, the value nested too deeply is not validated, and an InternalError wrapping
+	// ErrRecursionTooDeep is reported in its place, e.g. among the errors of the elements of a slice.
+	// A value of zero or less disables the limit.
+	MaxDepth = 1000
+
 	// ErrorTag is the struct tag name used to customize the error field name for a struct field.
 	ErrorTag = "json"
 
@@ -60,28 +80,79 @@ var (
 // Validate validates the given value and returns the validation error, if any.
 //
 // Validate performs validation using the following steps:
-//  1. For each rule, call its `Validate()` to validate the value. Return if any error is found.
+//  1. For each rule, call its `Validate()` to validate the value. Return if any error that is not a warning (see Warn) is found.
 //  2. If the value being validated implements `Validatable`, call the value's `Validate()`.
 //     Return with the validation result.
 //  3. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //     for each element call the element value's `Validate()`. Return with the validation result.
+//
+// A value (or an element type) that only implements `ValidatableWithContext` is validated by calling its
+// `ValidateWithContext()` with context.Background(), so that it is not silently skipped.
+// If the value is stored in an interface (e.g. a struct field of type interface{}), its dynamic value is validated.
+//
+// A cycle in the data is skipped for the values implementing `ValidatableWithContext`, as described by
+// ValidateWithContext. A `Validate()` method has no context to record the values it is nested in, so a value that
+// is validated through its own `Validate()` method (e.g. a node whose Next field points to itself) is validated
+// again until MaxDepth is exceeded, and an InternalError wrapping ErrRecursionTooDeep is returned. Recursive models
+// should implement `ValidatableWithContext` to have their cycles skipped instead.
 func Validate(value interface{}, rules ...Rule) error {
+	switch v := value.(type) {
+	case string:
+		return validateString(v, value, rules)
+	case int:
+		return validateInt(v, value, rules)
+	case bool:
+		return validateBool(v, value, rules)
+	}
+	return validate(value, rules)
+}
+
+// ValidateString validates a string with the given rules and returns the validation error, if any.
+// It behaves exactly like Validate, but does not store the string in an interface{}, which allocates memory,
+// as long as every rule can check a string directly. Required, Length, Match and the rules created by
+// NewStringRule (including most rules of the is package) can, so ValidateString is allocation free for them:
+//
+//	err := valid.ValidateString(name, valid.Required, nameLength, nameFormat)
+//
+// Note that the rules should be created once and reused, as creating a rule such as Length(5, 20) allocates memory.
+func ValidateString(value string, rules ...Rule) error {
+	return validateString(value, nil, rules)
+}
+
+// validate validates the given value with the given rules as described by Validate, without the fast paths.
+func validate(value interface{}, rules []Rule) error {
+	var warning error
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
-			return nil
+			return warning
 		}
 		if err := rule.Validate(value); err != nil {
-			return err
+			if err = localize(nil, err); !isWarning(err) {
+				return err
+			} else if warning == nil {
+				warning = err
+			}
 		}
 	}
 
+	if err := validateValue(value); err != nil {
+		return err
+	}
+	return warning
+}
+
+// validateValue validates the given value itself, or its elements, if it is validatable as described by Validate.
+func validateValue(value interface{}) error {
 	rv := reflect.ValueOf(value)
 	if (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil() {
 		return nil
 	}
 
 	if v, ok := value.(Validatable); ok {
-		return v.Validate()
+		return validatePlain(v)
+	}
+	if v, ok := value.(ValidatableWithContext); ok {
+		return validateNested(context.Background(), v)
 	}
 
 	switch rv.Kind() {
@@ -89,9 +160,14 @@ func Validate(value interface{}, rules ...Rule) error {
 		if rv.Type().Elem().Implements(validatableType) {
 			return validateMap(rv)
 		}
+		if rv.Type().Elem().Implements(validatableWithContextType) {
+			return validateMapWithContext(context.Background(), rv)
+		}
 	case reflect.Slice, reflect.Array:
-		if rv.Type().Elem().Implements(validatableType) {
+		if et := sliceElemType(rv); et.Implements(validatableType) {
 			return validateSlice(rv)
+		} else if et.Implements(validatableWithContextType) {
+			return validateSliceWithContext(context.Background(), rv)
 		}
 	case reflect.Ptr, reflect.Interface:
 		return Validate(rv.Elem().Interface())
@@ -100,11 +176,105 @@ func Validate(value interface{}, rules ...Rule) error {
 	return nil
 }
 
+// checkString checks a string with the rule without reflection if the rule is of a type that supports it,
+// returning the same error as the Validate method of the rule. The boolean result is false if it is not supported.
+// The concrete types are matched so that a type embedding one of them and overriding Validate is not affected.
+func checkString(rule Rule, value string) (bool, error) {
+	switch r := rule.(type) {
+	case RequiredRule:
+		return true, r.validateString(value)
+	case LengthRule:
+		return true, r.validateString(value)
+	case MatchRule:
+		return true, r.validateString(value)
+	case StringRule:
+		return true, r.validateString(value)
+	}
+	return false, nil
+}
+
+// validateString validates a string with the rules, checking it with checkString when possible.
+// boxed is the string stored in an interface{}, or nil if it is not stored yet, in which case it is stored only
+// when a rule without a fast path is met. A string is not Validatable, so nothing is left to validate after the rules.
+func validateString(value string, boxed interface{}, rules []Rule) error {
+	var warning error
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return warning
+		}
+		ok, err := checkString(rule, value)
+		if !ok {
+			if boxed == nil {
+				boxed = value
+			}
+			err = rule.Validate(boxed)
+		}
+		if err != nil {
+			if err = localize(nil, err); !isWarning(err) {
+				return err
+			} else if warning == nil {
+				warning = err
+			}
+		}
+	}
+	return warning
+}
+
+// validateInt validates an int with the rules, checking it without reflection for Required.
+// An int is not Validatable, so nothing is left to validate after the rules.
+func validateInt(value int, boxed interface{}, rules []Rule) error {
+	var warning error
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return warning
+		}
+		var err error
+		if r, ok := rule.(RequiredRule); ok {
+			err = r.validateInt(value)
+		} else {
+			err = rule.Validate(boxed)
+		}
+		if err != nil {
+			if err = localize(nil, err); !isWarning(err) {
+				return err
+			} else if warning == nil {
+				warning = err
+			}
+		}
+	}
+	return warning
+}
+
+// validateBool validates a bool with the rules, checking it without reflection for Required.
+// A bool is not Validatable, so nothing is left to validate after the rules.
+func validateBool(value bool, boxed interface{}, rules []Rule) error {
+	var warning error
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return warning
+		}
+		var err error
+		if r, ok := rule.(RequiredRule); ok {
+			err = r.validateBool(value)
+		} else {
+			err = rule.Validate(boxed)
+		}
+		if err != nil {
+			if err = localize(nil, err); !isWarning(err) {
+				return err
+			} else if warning == nil {
+				warning = err
+			}
+		}
+	}
+	return warning
+}
+
 // ValidateWithContext validates the given value with the given context and returns the validation error, if any.
 //
 // ValidateWithContext performs validation using the following steps:
 //  1. For each rule, call its `ValidateWithContext()` to validate the value if the rule implements `RuleWithContext`.
-//     Otherwise call `Validate()` of the rule. Return if any error is found.
+//     Otherwise call `Validate()` of the rule. Return if any error that is not a warning (see Warn) is found.
 //  2. If the value being validated implements `ValidatableWithContext`, call the value's `ValidateWithContext()`
 //     and return with the validation result.
 //  3. If the value being validated implements `Validatable`, call the value's `Validate()`
@@ -113,31 +283,57 @@ func Validate(value interface{}, rules ...Rule) error {
 //     for each element call the element value's `ValidateWithContext()`. Return with the validation result.
 //  5. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //     for each element call the element value's `Validate()`. Return with the validation result.
+//
+// If the context is prepared by WithRuleErrors(), the error returned by a failed rule is wrapped into a RuleError.
+// If the context is prepared by WithLocale(), the errors are reported in its locale.
+//
+// The context passed to `ValidateWithContext()` of a validatable value records the values it is nested in.
+// A pointer that is already being validated by one of them (e.g. a tree node that is its own descendant) is skipped
+// rather than validated again, so a cycle in the data does not cause infinite recursion, and an InternalError
+// wrapping ErrRecursionTooDeep is reported for a value nested deeper than MaxDepth. The values are only recorded
+// through `ValidatableWithContext`, so recursive models should implement it and pass the context along.
 func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	var warning error
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
-			return nil
+			return warning
 		}
+		var err error
 		if rc, ok := rule.(RuleWithContext); ok {
-			if err := rc.ValidateWithContext(ctx, value); err != nil {
+			err = rc.ValidateWithContext(ctx, value)
+		} else {
+			err = rule.Validate(value)
+		}
+		if err != nil {
+			if err = wrapRuleError(ctx, rule, value, localize(ctx, err)); !isWarning(err) {
 				return err
+			} else if warning == nil {
+				warning = err
 			}
-		} else if err := rule.Validate(value); err != nil {
-			return err
 		}
 	}
 
+	if err := validateValueWithContext(ctx, value); err != nil {
+		return err
+	}
+	return warning
+}
+
+// validateValueWithContext validates the given value itself, or its elements, with the given context if it is
+// validatable as described by ValidateWithContext.
+func validateValueWithContext(ctx context.Context, value interface{}) error {
 	rv := reflect.ValueOf(value)
 	if (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) && rv.IsNil() {
 		return nil
 	}
 
 	if v, ok := value.(ValidatableWithContext); ok {
-		return v.ValidateWithContext(ctx)
+		return validateNested(ctx, v)
 	}
 
+	// the values validated without the context are localized here, in case the context has a locale
 	if v, ok := value.(Validatable); ok {
-		return v.Validate()
+		return localize(ctx, validatePlain(v))
 	}
 
 	switch rv.Kind() {
@@ -146,14 +342,13 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 			return validateMapWithContext(ctx, rv)
 		}
 		if rv.Type().Elem().Implements(validatableType) {
-			return validateMap(rv)
+			return localize(ctx, validateMap(rv))
 		}
 	case reflect.Slice, reflect.Array:
-		if rv.Type().Elem().Implements(validatableWithContextType) {
+		if et := sliceElemType(rv); et.Implements(validatableWithContextType) {
 			return validateSliceWithContext(ctx, rv)
-		}
-		if rv.Type().Elem().Implements(validatableType) {
-			return validateSlice(rv)
+		} else if et.Implements(validatableType) {
+			return localize(ctx, validateSlice(rv))
 		}
 	case reflect.Ptr, reflect.Interface:
 		return ValidateWithContext(ctx, rv.Elem().Interface())
@@ -162,12 +357,108 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 	return nil
 }
 
+// ValidateValue validates the value held by the given reflect.Value and returns the validation error, if any.
+// It allows tools built upon reflection (e.g. form libraries) to validate values without converting them back
+// to interface{} themselves, and behaves the same as Validate for the equivalent interface{} value, except that
+// if the value is addressable and only its pointer implements Validatable, the pointer's Validate() is called
+// after the rules pass. The rules are still given the value itself.
+// An invalid (zero) reflect.Value is validated as nil. An internal error is returned if the value cannot be
+// used without panicking (e.g. it is obtained from an unexported struct field).
+func ValidateValue(v reflect.Value, rules ...Rule) error {
+	return validateReflectValue(nil, v, rules)
+}
+
+// ValidateValueWithContext validates the value held by the given reflect.Value with the given context.
+// Please refer to ValidateValue and ValidateWithContext for more details.
+func ValidateValueWithContext(ctx context.Context, v reflect.Value, rules ...Rule) error {
+	return validateReflectValue(ctx, v, rules)
+}
+
+// validateReflectValue validates the value held by v with the rules. If only the pointer to the value implements
+// Validatable or ValidatableWithContext, the pointer is validated after the rules pass (or only report warnings),
+// unless they are skipped. If ctx is nil, the value is validated without a context.
+func validateReflectValue(ctx context.Context, v reflect.Value, rules []Rule) error {
+	value, err := valueOf(v)
+	if err != nil {
+		return err
+	}
+	ptr := onlyPtrValidatable(v)
+	if ptr {
+		value = v.Interface()
+	}
+	if ctx == nil {
+		err = Validate(value, rules...)
+	} else {
+		err = ValidateWithContext(ctx, value, rules...)
+	}
+	if !ptr || err != nil && !isWarning(err) || skips(rules) {
+		return err
+	}
+	var nestedErr error
+	if ctx == nil {
+		nestedErr = validateValue(v.Addr().Interface())
+	} else {
+		nestedErr = validateValueWithContext(ctx, v.Addr().Interface())
+	}
+	if nestedErr != nil {
+		return nestedErr
+	}
+	return err
+}
+
+// skips reports whether the rules contain Skip, which stops the validation of the value.
+func skips(rules []Rule) bool {
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return true
+		}
+	}
+	return false
+}
+
+// valueOf returns the value held by v as an interface{}. If v is addressable and only the pointer to the value
+// implements Validatable or ValidatableWithContext, the pointer is returned instead.
+func valueOf(v reflect.Value) (interface{}, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if !v.CanInterface() {
+		return nil, NewInternalError(ErrValueNotInterface)
+	}
+	if onlyPtrValidatable(v) {
+		return v.Addr().Interface(), nil
+	}
+	return v.Interface(), nil
+}
+
+// onlyPtrValidatable reports whether v is addressable and only the pointer to its value implements Validatable or
+// ValidatableWithContext.
+func onlyPtrValidatable(v reflect.Value) bool {
+	return v.CanAddr() && !isValidatable(v.Type()) && isValidatable(reflect.PtrTo(v.Type()))
+}
+
+// isValidatable reports whether the given type implements Validatable or ValidatableWithContext.
+func isValidatable(t reflect.Type) bool {
+	return t.Implements(validatableType) || t.Implements(validatableWithContextType)
+}
+
+// sliceElemType returns the element type of a slice or an array. Because the elements of a slice are addressable,
+// the pointer type is returned for a slice if only the pointer to an element implements Validatable or
+// ValidatableWithContext, so that the Validate() methods with pointer receivers are called.
+func sliceElemType(rv reflect.Value) reflect.Type {
+	et := rv.Type().Elem()
+	if rv.Kind() == reflect.Slice && !isValidatable(et) && isValidatable(reflect.PtrTo(et)) {
+		return reflect.PtrTo(et)
+	}
+	return et
+}
+
 // validateMap validates a map of validatable elements
 func validateMap(rv reflect.Value) error {
 	errs := Errors{}
 	for _, key := range rv.MapKeys() {
-		if mv := rv.MapIndex(key).Interface(); mv != nil {
-			if err := mv.(Validatable).Validate(); err != nil {
+		if mv := rv.MapIndex(key); !isNilElem(mv) {
+			if err := validatePlain(mv.Interface().(Validatable)); err != nil {
 				errs[fmt.Sprintf("%v", key.Interface())] = err
 			}
 		}
@@ -182,8 +473,8 @@ func validateMap(rv reflect.Value) error {
 func validateMapWithContext(ctx context.Context, rv reflect.Value) error {
 	errs := Errors{}
 	for _, key := range rv.MapKeys() {
-		if mv := rv.MapIndex(key).Interface(); mv != nil {
-			if err := mv.(ValidatableWithContext).ValidateWithContext(ctx); err != nil {
+		if mv := rv.MapIndex(key); !isNilElem(mv) {
+			if err := validateNested(ctx, mv.Interface().(ValidatableWithContext)); err != nil {
 				errs[fmt.Sprintf("%v", key.Interface())] = err
 			}
 		}
@@ -199,8 +490,9 @@ func validateSlice(rv reflect.Value) error {
 	errs := Errors{}
 	l := rv.Len()
 	for i := 0; i < l; i++ {
-		if ev := rv.Index(i).Interface(); ev != nil {
-			if err := ev.(Validatable).Validate(); err != nil {
+		if ev := rv.Index(i); !isNilElem(ev) {
+			value, _ := valueOf(ev)
+			if err := validatePlain(value.(Validatable)); err != nil {
 				errs[strconv.Itoa(i)] = err
 			}
 		}
@@ -216,8 +508,9 @@ func validateSliceWithContext(ctx context.Context, rv reflect.Value) error {
 	errs := Errors{}
 	l := rv.Len()
 	for i := 0; i < l; i++ {
-		if ev := rv.Index(i).Interface(); ev != nil {
-			if err := ev.(ValidatableWithContext).ValidateWithContext(ctx); err != nil {
+		if ev := rv.Index(i); !isNilElem(ev) {
+			value, _ := valueOf(ev)
+			if err := validateNested(ctx, value.(ValidatableWithContext)); err != nil {
 				errs[strconv.Itoa(i)] = err
 			}
 		}
@@ -228,6 +521,97 @@ func validateSliceWithContext(ctx context.Context, rv reflect.Value) error {
 	return nil
 }
 
+type (
+	// nestingKey is the context key of the nesting of validatable values whose ValidateWithContext() is being called.
+	nestingKey struct{}
+
+	// nesting describes a validatable value whose ValidateWithContext() is being called, and links to the value
+	// it is nested in. The pointer is only set if the value is a pointer to a non-zero-sized value.
+	nesting struct {
+		depth  int
+		ptr    uintptr
+		typ    reflect.Type
+		parent *nesting
+	}
+)
+
+// validateNested calls ValidateWithContext() of the value with a context that records one more level of nesting.
+// An internal error is returned instead if the nesting exceeds MaxDepth. If the value is a pointer that is already
+// being validated by one of the values it is nested in, i.e. the data has a cycle, it is not validated again.
+func validateNested(ctx context.Context, v ValidatableWithContext) error {
+	parent, _ := ctx.Value(nestingKey{}).(*nesting)
+	n := &nesting{parent: parent}
+	if parent != nil {
+		n.depth = parent.depth + 1
+	}
+	if MaxDepth > 0 && n.depth >= MaxDepth {
+		return NewInternalError(ErrRecursionTooDeep)
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.Type().Elem().Size() > 0 {
+		n.ptr, n.typ = rv.Pointer(), rv.Type()
+		for p := parent; p != nil; p = p.parent {
+			if p.ptr == n.ptr && p.typ == n.typ {
+				return nil
+			}
+		}
+	}
+	return v.ValidateWithContext(context.WithValue(ctx, nestingKey{}, n))
+}
+
+var (
+	// plainCalls is the number of Validate() calls made by validatePlain that have not returned yet, in all goroutines.
+	plainCalls atomic.Int64
+	// validatePlainEntry is the entry address of validatePlain, which identifies its frames on the stack.
+	validatePlainEntry uintptr
+)
+
+func init() {
+	validatePlainEntry = reflect.ValueOf(validatePlain).Pointer()
+}
+
+// validatePlain calls Validate() of a value. Unlike ValidateWithContext(), Validate() has no context to record the
+// values it is nested in, so the nesting is found on the stack of the goroutine instead: an internal error wrapping
+// ErrRecursionTooDeep is returned if the call would nest deeper than MaxDepth on it, e.g. because the data has
+// a cycle. The stack is only inspected when that many calls are being made in all goroutines, which keeps the check
+// cheap for the usual shallow data.
+//
+//go:noinline
+func validatePlain(v Validatable) error {
+	defer plainCalls.Add(-1)
+	if n := plainCalls.Add(1); MaxDepth > 0 && n > int64(MaxDepth) && plainDepth() > MaxDepth {
+		return NewInternalError(ErrRecursionTooDeep)
+	}
+	return v.Validate()
+}
+
+// plainDepth returns the number of validatePlain calls on the stack of the current goroutine.
+func plainDepth() int {
+	pcs := make([]uintptr, 1024)
+	for {
+		if n := runtime.Callers(2, pcs); n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, 2*len(pcs))
+	}
+	depth := 0
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Entry == validatePlainEntry {
+			depth++
+		}
+		if !more {
+			return depth
+		}
+	}
+}
+
+// isNilElem reports whether an element of a map/slice/array is a nil pointer or interface, which is not validated.
+func isNilElem(v reflect.Value) bool {
+	return (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil()
+}
+
 type skipRule struct {
 	skip bool
 }
@@ -242,6 +626,21 @@ func (r skipRule) When(condition bool) skipRule {
 	return r
 }
 
+// Validate calls the function to validate the value.
+func (f RuleFunc) Validate(value interface{}) error {
+	return f(value)
+}
+
+// Validate calls the function with context.Background() to validate the value.
+func (f RuleWithContextFunc) Validate(value interface{}) error {
+	return f(context.Background(), value)
+}
+
+// ValidateWithContext calls the function with the given context to validate the value.
+func (f RuleWithContextFunc) ValidateWithContext(ctx context.Context, value interface{}) error {
+	return f(ctx, value)
+}
+
 type inlineRule struct {
 	f  RuleFunc
 	fc RuleWithContextFunc
@@ -262,6 +661,7 @@ func (r *inlineRule) ValidateWithContext(ctx context.Context, value interface{})
 }
 
 // By wraps a RuleFunc into a Rule.
+// Since RuleFunc implements Rule itself, By(f) is equivalent to RuleFunc(f), and is kept for readability.
 func By(f RuleFunc) Rule {
 	return &inlineRule{f: f}
 }
@@ -270,3 +670,39 @@ func By(f RuleFunc) Rule {
 func WithContext(f RuleWithContextFunc) Rule {
 	return &inlineRule{fc: f}
 }
+
+type lazyRule struct {
+	factory func() Rule
+}
+
+func (r lazyRule) Validate(value interface{}) error {
+	if rule := r.factory(); rule != nil {
+		return rule.Validate(value)
+	}
+	return nil
+}
+
+func (r lazyRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	rule := r.factory()
+	if rc, ok := rule.(RuleWithContext); ok {
+		return rc.ValidateWithContext(ctx, value)
+	} else if rule != nil {
+		return rule.Validate(value)
+	}
+	return nil
+}
+
+// Lazy returns a rule that defers the construction of the actual rule until a value is validated.
+// It is useful when the rule depends on settings that are not known when the rule set is built, e.g.
+//
+//	valid.Field(&p.Title, valid.Lazy(func() valid.Rule {
+//	    return valid.Length(0, config.MaxTitleLength)
+//	}))
+//
+// The factory is called every time a value is validated and its result is not cached, so the rule
+// always reflects the current settings. Make the factory cheap, or cache the rule yourself if needed.
+// A nil rule returned by the factory is ignored. Note that returning Skip from the factory
+// does not skip the rules following the lazy rule.
+func Lazy(factory func() Rule) Rule {
+	return lazyRule{factory: factory}
+}
@@ -7,6 +7,9 @@ package valid
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -37,7 +40,7 @@ func TestValidate(t *testing.T) {
 		{"t4.2", map[string]StringValidateContext{}, "", ""},
 		{"t5", slice, "0: error 123; 2: error 123.", "0: error 123; 2: error 123."},
 		{"t6", &slice, "0: error 123; 2: error 123.", "0: error 123; 2: error 123."},
-		{"t7", ctxSlice, "", "1: (A: error abc.)."},
+		{"t7", ctxSlice, "1: (A: error abc.).", "1: (A: error abc.)."},
 		{"t8", mp, "a: error 123; c: error 123.", "a: error 123; c: error 123."},
 		{"t8.1", mpCtx, "a: must be abc; b: must be abc.", "a: must be abc with context; b: must be abc with context."},
 		{"t9", &mp, "a: error 123; c: error 123.", "a: error 123; c: error 123."},
@@ -78,6 +81,23 @@ func TestValidate(t *testing.T) {
 	assert.EqualError(t, err, "error xyz")
 }
 
+func TestValidate_Array(t *testing.T) {
+	arr := [2]Model3{{A: "xyz"}, {A: "abc"}}
+	ctxArr := [2]Model4{{A: "abc"}, {A: "xyz"}}
+
+	err := Validate(arr)
+	assertError(t, "0: (A: error abc.).", err, "t1")
+	err = Validate(&arr)
+	assertError(t, "0: (A: error abc.).", err, "t2")
+	err = ValidateWithContext(context.Background(), ctxArr)
+	assertError(t, "1: (A: error abc.).", err, "t3")
+	err = Validate([2]Model3{{A: "abc"}, {A: "abc"}})
+	assert.NoError(t, err)
+
+	err = Validate([3]string{"abc", "", "xyz"}, Each(Required, &validateAbc{}))
+	assertError(t, "1: cannot be blank; 2: error abc.", err, "t4")
+}
+
 func stringEqual(str string) RuleFunc {
 	return func(value interface{}) error {
 		s, _ := value.(string)
@@ -109,6 +129,39 @@ func TestBy(t *testing.T) {
 	assert.NotNil(t, ValidateWithContext(context.Background(), "abc", xyzRule))
 }
 
+func TestRuleFunc(t *testing.T) {
+	abcRule := RuleFunc(func(value interface{}) error {
+		if s, _ := value.(string); s != "abc" {
+			return errors.New("must be abc")
+		}
+		return nil
+	})
+	assert.Nil(t, Validate("abc", abcRule))
+	assertError(t, "must be abc", Validate("xyz", abcRule), "t1")
+	assertError(t, "must be abc", ValidateWithContext(context.Background(), "xyz", abcRule), "t2")
+	// a function returning RuleFunc can be passed as a rule directly
+	assertError(t, "unexpected string", Validate("xyz", stringEqual("abc")), "t3")
+
+	m := struct{ Name string }{"xyz"}
+	err := ValidateStruct(&m, Field(&m.Name, Required, abcRule))
+	assertError(t, "Name: must be abc.", err, "t4")
+}
+
+func TestRuleWithContextFunc(t *testing.T) {
+	k := key(1)
+	abcRule := RuleWithContextFunc(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(k) != value {
+			return errors.New("must be abc")
+		}
+		return nil
+	})
+	ctx := context.WithValue(context.Background(), k, "abc")
+	assert.Nil(t, ValidateWithContext(ctx, "abc", abcRule))
+	assertError(t, "must be abc", ValidateWithContext(ctx, "xyz", abcRule), "t1")
+	// without a context, the function is called with context.Background()
+	assertError(t, "must be abc", Validate("abc", abcRule), "t2")
+}
+
 type key int
 
 func TestByWithContext(t *testing.T) {
@@ -129,6 +182,113 @@ func TestByWithContext(t *testing.T) {
 	assert.NotNil(t, Validate("abc", abcRule))
 }
 
+func TestValidate_DoublePointer(t *testing.T) {
+	short, long, blank := "ab", "abcd", ""
+	pShort, pLong, pBlank := &short, &long, &blank
+	var nilInner *string
+	var nilOuter **string
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		rules []Rule
+		err   string
+	}{
+		{"t1.1", nilOuter, []Rule{Required}, "cannot be blank"},
+		{"t1.2", nilOuter, []Rule{Length(3, 5)}, ""},
+		{"t1.3", nilOuter, []Rule{NotNil}, "is required"},
+		{"t1.4", nilOuter, []Rule{Nil}, ""},
+		{"t2.1", &nilInner, []Rule{Required}, "cannot be blank"},
+		{"t2.2", &nilInner, []Rule{Length(3, 5)}, ""},
+		{"t2.3", &nilInner, []Rule{NotNil}, "is required"},
+		{"t2.4", &nilInner, []Rule{Nil}, ""},
+		{"t3.1", &pShort, []Rule{Required}, ""},
+		{"t3.2", &pShort, []Rule{Length(3, 5)}, "the length must be between 3 and 5"},
+		{"t3.3", &pLong, []Rule{Required, Length(3, 5), In("abcd")}, ""},
+		{"t3.4", &pLong, []Rule{NotNil}, ""},
+		{"t3.5", &pLong, []Rule{Nil}, "must be blank"},
+		{"t3.6", &pBlank, []Rule{Required}, "cannot be blank"},
+		{"t3.7", &pBlank, []Rule{NotNil, Empty}, ""},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+// tenantScoped is a validatable that only implements ValidatableWithContext.
+type tenantScoped struct {
+	Tenant string
+}
+
+func (s tenantScoped) ValidateWithContext(ctx context.Context) error {
+	tenant, _ := ctx.Value(contains).(string)
+	if s.Tenant != tenant {
+		return fmt.Errorf("must belong to tenant %q", tenant)
+	}
+	return nil
+}
+
+func TestValidate_ContextOnlyValidatable(t *testing.T) {
+	type request struct {
+		Owner  tenantScoped
+		Shared []tenantScoped
+		ByName map[string]*tenantScoped
+	}
+	r := request{
+		Owner:  tenantScoped{Tenant: "acme"},
+		Shared: []tenantScoped{{Tenant: "acme"}, {Tenant: "other"}},
+		ByName: map[string]*tenantScoped{"x": {Tenant: "acme"}, "y": nil},
+	}
+	fields := func(r *request) []*FieldRules {
+		return []*FieldRules{Field(&r.Owner), Field(&r.Shared), Field(&r.ByName)}
+	}
+
+	// the context is propagated to the nested validatables
+	ctx := context.WithValue(context.Background(), contains, "acme")
+	err := ValidateStructWithContext(ctx, &r, fields(&r)...)
+	assertError(t, "Shared: (1: must belong to tenant \"acme\".).", err, "t1")
+
+	// without a context, the validatables are validated with context.Background()
+	err = ValidateStruct(&r, fields(&r)...)
+	assertError(t, "ByName: (x: must belong to tenant \"\".); Owner: must belong to tenant \"\"; Shared: (0: must belong to tenant \"\"; 1: must belong to tenant \"\".).", err, "t2")
+	assertError(t, "must belong to tenant \"\"", Validate(&r.Owner), "t3")
+	assert.Nil(t, Validate(tenantScoped{}))
+	assert.Nil(t, Validate([]tenantScoped{{}}))
+}
+
+func TestLazy(t *testing.T) {
+	max, calls := 3, 0
+	r := Lazy(func() Rule {
+		calls++
+		return Length(0, max)
+	})
+	assert.Nil(t, Validate("abc", r))
+	assertError(t, "the length must be no more than 3", Validate("abcd", r), "t1")
+	max = 5
+	assert.Nil(t, Validate("abcd", r))
+	assert.Equal(t, 3, calls)
+
+	k := key(3)
+	rc := Lazy(func() Rule {
+		return WithContext(func(ctx context.Context, value interface{}) error {
+			if ctx.Value(k) != value {
+				return errors.New("must match the context")
+			}
+			return nil
+		})
+	})
+	ctx := context.WithValue(context.Background(), k, "abc")
+	assert.Nil(t, ValidateWithContext(ctx, "abc", rc))
+	assertError(t, "must match the context", ValidateWithContext(ctx, "xyz", rc), "t2")
+	assertError(t, "the length must be no more than 5", ValidateWithContext(ctx, "abcdef", r), "t3")
+
+	nilRule := Lazy(func() Rule { return nil })
+	assert.Nil(t, Validate("abc", nilRule))
+	assert.Nil(t, ValidateWithContext(ctx, "abc", nilRule))
+}
+
 func Test_skipRule_Validate(t *testing.T) {
 	assert.Nil(t, Skip.Validate(100))
 }
@@ -268,3 +428,302 @@ func (s StringValidateContext) ValidateWithContext(context.Context) error {
 	}
 	return nil
 }
+
+type ptrValidatable struct {
+	A string
+}
+
+func (m *ptrValidatable) Validate() error {
+	return ValidateStruct(m, Field(&m.A, &validateAbc{}))
+}
+
+type ptrContextValidatable struct {
+	A string
+}
+
+func (m *ptrContextValidatable) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, m, Field(&m.A, &validateAbc{}))
+}
+
+// internalElem fails its validation with an internal error if it is negative, or with a validation error if it is zero.
+type internalElem int
+
+func (e internalElem) Validate() error {
+	switch {
+	case e < 0:
+		return NewInternalError(errors.New("internal"))
+	case e == 0:
+		return errors.New("zero")
+	}
+	return nil
+}
+
+func TestValidate_ElementInternalErrors(t *testing.T) {
+	// the internal errors of the elements are reported with the errors of the other elements
+	slice := []internalElem{-1, 0, 1}
+	err := Validate(slice)
+	assertError(t, "0: internal; 1: zero.", err, "t1")
+	if es, ok := err.(Errors); assert.True(t, ok, "t1") {
+		_, ok = es["0"].(InternalError)
+		assert.True(t, ok, "t1")
+	}
+	assertError(t, "0: internal; 1: zero.", ValidateWithContext(context.Background(), slice), "t2")
+	assertError(t, "a: internal; b: zero.", Validate(map[string]internalElem{"a": -1, "b": 0, "c": 1}), "t3")
+
+	rule := Each(By(func(value interface{}) error {
+		return internalElem(value.(int)).Validate()
+	}))
+	assertError(t, "0: internal; 1: zero.", Validate([]int{-1, 0, 1}, rule), "t4")
+	assertError(t, "a: internal; b: zero.", Validate(map[string]int{"a": -1, "b": 0}, rule), "t5")
+}
+
+func TestValidate_PointerReceiver(t *testing.T) {
+	slice := []ptrValidatable{{A: "xyz"}, {A: "abc"}}
+	assertError(t, "0: (A: error abc.).", Validate(slice), "t1")
+	assertError(t, "0: (A: error abc.).", ValidateWithContext(context.Background(), slice), "t2")
+	assertError(t, "0: (A: error abc.).", Validate(&slice), "t3")
+	assert.Nil(t, Validate([]ptrValidatable{{A: "abc"}}))
+
+	ctxSlice := []ptrContextValidatable{{A: "abc"}, {A: "xyz"}}
+	assertError(t, "1: (A: error abc.).", Validate(ctxSlice), "t4")
+	assertError(t, "1: (A: error abc.).", ValidateWithContext(context.Background(), ctxSlice), "t5")
+
+	// the elements of an array held in an interface{} are not addressable, so they are not validated
+	assert.Nil(t, Validate([1]ptrValidatable{{A: "xyz"}}))
+	// a pointer is validated with its Validate() method, while a value held in an interface{} cannot be
+	assertError(t, "A: error abc.", Validate(&ptrValidatable{A: "xyz"}), "t6")
+	assert.Nil(t, Validate(ptrValidatable{A: "xyz"}))
+}
+
+func TestValidateValue(t *testing.T) {
+	s := struct {
+		A     string
+		M     Model3
+		P     ptrValidatable
+		C     Model4
+		items []string
+	}{A: "xyz", M: Model3{A: "xyz"}, P: ptrValidatable{A: "xyz"}, C: Model4{A: "xyz"}}
+	v := reflect.ValueOf(&s).Elem()
+
+	assert.Nil(t, ValidateValue(v.Field(0), Required))
+	assertError(t, "error abc", ValidateValue(v.Field(0), &validateAbc{}), "t1")
+	assertError(t, "A: error abc.", ValidateValue(v.Field(1)), "t2")
+	assertError(t, "A: error abc.", ValidateValue(v.Field(2)), "t3")
+	assertError(t, "A: error abc.", ValidateValueWithContext(context.Background(), v.Field(2)), "t4")
+	assertError(t, "A: error abc.", ValidateValueWithContext(context.Background(), v.Field(3)), "t5")
+	// a non-addressable value is validated as is
+	assert.Nil(t, ValidateValue(reflect.ValueOf(s).Field(2)))
+	assertError(t, "cannot be blank", ValidateValue(reflect.Value{}, Required), "t6")
+	assert.Nil(t, ValidateValue(reflect.Value{}))
+
+	err := ValidateValue(v.Field(4), Required)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, ErrValueNotInterface, err.(InternalError).InternalError())
+	}
+	err = ValidateValueWithContext(context.Background(), v.Field(4), Required)
+	assert.Equal(t, ErrValueNotInterface, err.(InternalError).InternalError())
+}
+
+// treeNode is a recursive model used to test the nesting limit of validatable values.
+type treeNode struct {
+	Name     string
+	Children []*treeNode
+}
+
+func (n *treeNode) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, n,
+		Field(&n.Name, Required),
+		Field(&n.Children),
+	)
+}
+
+// plainNode only implements Validatable, so the values it is nested in cannot be recorded.
+type plainNode struct {
+	Name string
+	Next *plainNode
+}
+
+func (n *plainNode) Validate() error {
+	return ValidateStruct(n, Field(&n.Name, Required), Field(&n.Next))
+}
+
+// eachTreeNode validates its children with an Each rule.
+type eachTreeNode struct {
+	Children []*eachTreeNode
+}
+
+func (n *eachTreeNode) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, n, Field(&n.Children, Each(NotNil)))
+}
+
+func TestValidate_FastPath(t *testing.T) {
+	custom := errors.New("custom")
+	digits := regexp.MustCompile(`^[0-9]+$`)
+	upper := NewStringRule(func(s string) bool { return strings.ToUpper(s) == s }, "must be in upper case")
+	by := By(func(value interface{}) error {
+		if value == "stop" {
+			return custom
+		}
+		return nil
+	})
+	rules := [][]Rule{
+		{Required},
+		{Required.Trim()},
+		{Required.When(false)},
+		{Required.Error("is missing")},
+		{NilOrNotEmpty},
+		{NilOrNotEmpty.Trim().Error("is missing")},
+		{Length(2, 4)},
+		{Length(2, 4).Trim()},
+		{RuneLength(2, 3)},
+		{Length(0, 0)},
+		{Length(0, 0).ExclusiveMin()},
+		{Length(2, 4).ExclusiveMin().ExclusiveMax()},
+		{Match(digits)},
+		{MatchFull(regexp.MustCompile(`[0-9]`)).Example("7")},
+		{upper},
+		{Required, Length(2, 4), Match(digits)},
+		{by, Required, Length(2, 4)},
+		{Required, Skip, Length(10, 0)},
+		{Skip.When(false), Length(10, 0)},
+	}
+	strs := []string{"", " ", "  ", "1", "12", " 12 ", "1234", "12345", "abc", "ABC", "héé", "stop"}
+
+	for i, rs := range rules {
+		for _, str := range strs {
+			expected := validate(str, rs)
+			assert.Equal(t, expected, Validate(str, rs...), "t%v %q", i, str)
+			assert.Equal(t, expected, ValidateString(str, rs...), "t%v %q", i, str)
+		}
+		for _, n := range []int{0, 1, -1} {
+			assert.Equal(t, validate(n, rs), Validate(n, rs...), "t%v %v", i, n)
+		}
+		for _, b := range []bool{false, true} {
+			assert.Equal(t, validate(b, rs), Validate(b, rs...), "t%v %v", i, b)
+		}
+	}
+}
+
+func TestValidateString_Allocs(t *testing.T) {
+	rules := []Rule{Required, Length(2, 10), Match(regexp.MustCompile(`^[a-z]+$`))}
+	names := []string{"hello", "world"}
+	i := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		i++
+		_ = ValidateString(names[i%2], rules...)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkValidate_String(b *testing.B) {
+	rules := []Rule{Required, Length(2, 10), Match(regexp.MustCompile(`^[a-z]+$`))}
+	names := []string{"hello", "world"}
+
+	b.Run("validate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = validate(names[i%2], rules)
+		}
+	})
+	b.Run("Validate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Validate(names[i%2], rules...)
+		}
+	})
+	b.Run("ValidateString", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ValidateString(names[i%2], rules...)
+		}
+	})
+}
+
+func TestMaxDepth(t *testing.T) {
+	// the value nested too deeply is reported by an internal error among the errors of the elements
+	isTooDeep := func(err error) bool {
+		found := false
+		if es, ok := err.(interface {
+			Walk(fn func(path []string, err error))
+		}); ok {
+			es.Walk(func(_ []string, err error) {
+				if ie, ok := err.(InternalError); ok && ie.InternalError() == ErrRecursionTooDeep {
+					found = true
+				}
+			})
+		}
+		return found
+	}
+	chain := func(n int) *treeNode {
+		node := &treeNode{Name: "leaf"}
+		for i := 1; i < n; i++ {
+			node = &treeNode{Name: "node", Children: []*treeNode{node}}
+		}
+		return node
+	}
+
+	// a chain of nodes is valid as long as it is not deeper than MaxDepth
+	defer func(depth int) { MaxDepth = depth }(MaxDepth)
+	MaxDepth = 3
+	assert.Nil(t, Validate(chain(3)))
+	err := Validate(chain(4))
+	assert.True(t, isTooDeep(err), "%v", err)
+	assert.Equal(t, "Children: (0: (Children: (0: (Children: (0: validation recursion too deep.).).).).).", err.Error())
+	err = ValidateWithContext(context.Background(), []*treeNode{{Name: "a"}, chain(4)})
+	assert.True(t, isTooDeep(err), "%v", err)
+	err = Validate(map[string]*treeNode{"a": chain(4)})
+	assert.True(t, isTooDeep(err), "%v", err)
+	leaf := chain(3)
+	leaf.Children[0].Children[0].Name = ""
+	assertError(t, "Children: (0: (Children: (0: (Name: cannot be blank.).).).).", Validate(leaf), "t1")
+
+	MaxDepth = 0
+	assert.Nil(t, Validate(chain(10)))
+}
+
+func TestValidate_Cycle(t *testing.T) {
+	// a node that is its own child
+	self := &treeNode{Name: "self"}
+	self.Children = []*treeNode{self}
+	assert.Nil(t, Validate(self))
+	self.Name = ""
+	assertError(t, "Name: cannot be blank.", Validate(self), "t1")
+
+	// a node that is its own descendant is validated once on each path
+	root := &treeNode{Name: "root"}
+	child := &treeNode{}
+	root.Children = []*treeNode{child}
+	child.Children = []*treeNode{root}
+	assertError(t, "Children: (0: (Name: cannot be blank.).).", ValidateWithContext(context.Background(), root), "t2")
+	assertError(t, "0: (Children: (0: (Name: cannot be blank.).).).", Validate([]*treeNode{root}), "t3")
+
+	// a node shared by different paths without a cycle is validated on each of them
+	shared := &treeNode{}
+	dag := &treeNode{Name: "dag", Children: []*treeNode{shared, {Name: "a", Children: []*treeNode{shared}}}}
+	assertError(t, "Children: (0: (Name: cannot be blank.); 1: (Children: (0: (Name: cannot be blank.).).).).", Validate(dag), "t4")
+
+	e := &eachTreeNode{}
+	e.Children = []*eachTreeNode{e, {}}
+	assert.Nil(t, Validate(e))
+
+	// a cycle through Validate() methods, which cannot pass the nesting along, is reported once MaxDepth is exceeded
+	p := &plainNode{Name: "self"}
+	p.Next = p
+	tooDeep := func(err error) bool {
+		ie, ok := err.(InternalError)
+		return ok && ie.InternalError() == ErrRecursionTooDeep
+	}
+	assert.True(t, tooDeep(Validate(p)))
+	assert.True(t, tooDeep(ValidateWithContext(context.Background(), p)))
+	if es, ok := Validate([]*plainNode{p}).(Errors); assert.True(t, ok) {
+		assert.True(t, tooDeep(es["0"]))
+	}
+
+	// the nesting is counted the same way as for ValidatableWithContext
+	defer func(depth int) { MaxDepth = depth }(MaxDepth)
+	chain := &plainNode{Name: "a", Next: &plainNode{Name: "b", Next: &plainNode{}}}
+	MaxDepth = 3
+	assertError(t, "Next: (Next: (Name: cannot be blank.).).", Validate(chain), "t5")
+	MaxDepth = 2
+	assert.True(t, tooDeep(Validate(chain)))
+}
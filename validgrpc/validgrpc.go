@@ -0,0 +1,91 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package validgrpc converts validation errors into gRPC error details (google.rpc.BadRequest).
+// It is a separate module so that the valid module does not depend on the gRPC and protobuf modules.
+package validgrpc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/maksliu/valid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// walker is implemented by valid.Errors, valid.OrderedErrors, valid.KeyedErrors and valid.FieldErrors.
+type walker interface {
+	Walk(fn func(path []string, err error))
+}
+
+// FieldViolations converts a validation error returned by the valid package into the field violations of
+// a google.rpc.BadRequest error detail. Each leaf error of valid.Errors (or valid.OrderedErrors) becomes a violation
+// whose field is the path of the error with its segments separated by dots (e.g. "address.zip") and whose
+// description is the error message. Any other error is reported as a single violation with an empty field.
+// Internal errors (see valid.InternalError) are not validation errors and are left out, so that their messages
+// are not sent to clients. Nil is returned if err is nil. Use Status to convert err into a gRPC status directly.
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	if err == nil {
+		return nil
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	if w, ok := err.(walker); ok {
+		w.Walk(func(path []string, err error) {
+			if !isInternal(err) {
+				violations = append(violations, newFieldViolation(strings.Join(path, "."), err))
+			}
+		})
+	} else if !isInternal(err) {
+		violations = append(violations, newFieldViolation("", err))
+	}
+	return violations
+}
+
+// Status converts a validation error returned by the valid package into a gRPC status. If err is or contains
+// an internal error (see valid.InternalError), the status has the codes.Internal code and a generic message,
+// without the message of the internal error. Otherwise, the status has the codes.InvalidArgument code and
+// a google.rpc.BadRequest detail listing the field violations of err (see FieldViolations).
+// Nil is returned if err is nil. For example,
+//
+//	if err := req.Validate(); err != nil {
+//	    return nil, validgrpc.Status(err).Err()
+//	}
+func Status(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	if hasInternal(err) {
+		return status.New(codes.Internal, "internal error")
+	}
+	st := status.New(codes.InvalidArgument, "invalid request")
+	if detailed, e := st.WithDetails(&errdetails.BadRequest{FieldViolations: FieldViolations(err)}); e == nil {
+		st = detailed
+	}
+	return st
+}
+
+// hasInternal reports whether err is an internal error or has one among its leaf errors.
+func hasInternal(err error) bool {
+	w, ok := err.(walker)
+	if !ok {
+		return isInternal(err)
+	}
+	found := false
+	w.Walk(func(path []string, err error) {
+		found = found || isInternal(err)
+	})
+	return found
+}
+
+// isInternal reports whether err is (or wraps) an internal error.
+func isInternal(err error) bool {
+	var ie valid.InternalError
+	return errors.As(err, &ie) && ie.InternalError() != nil
+}
+
+func newFieldViolation(field string, err error) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: err.Error()}
+}
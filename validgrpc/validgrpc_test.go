@@ -0,0 +1,111 @@
+// Copyright 2016 Qiang Xue. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package validgrpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maksliu/valid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+// pairs returns the field and description of each violation.
+func pairs(violations []*errdetails.BadRequest_FieldViolation) [][2]string {
+	result := [][2]string{}
+	for _, v := range violations {
+		result = append(result, [2]string{v.GetField(), v.GetDescription()})
+	}
+	return result
+}
+
+func TestFieldViolations(t *testing.T) {
+	err := valid.Errors{
+		"name": valid.ErrRequired,
+		"address": valid.Errors{
+			"zip": valid.ErrMatchInvalid,
+		},
+		"tags": valid.Errors{
+			"1": errors.New("too long"),
+		},
+	}
+	assert.Equal(t, [][2]string{
+		{"address.zip", "must be in a valid format"},
+		{"name", "cannot be blank"},
+		{"tags.1", "too long"},
+	}, pairs(FieldViolations(err)))
+
+	assert.Equal(t, [][2]string{{"", "abc"}}, pairs(FieldViolations(errors.New("abc"))))
+	assert.Nil(t, FieldViolations(nil))
+	assert.Equal(t, [][2]string{}, pairs(FieldViolations(valid.Errors{})))
+
+	// internal errors are not listed
+	internal := valid.NewInternalError(errors.New("db down"))
+	assert.Equal(t, [][2]string{}, pairs(FieldViolations(internal)))
+	assert.Equal(t, [][2]string{{"name", "cannot be blank"}}, pairs(FieldViolations(valid.Errors{
+		"name": valid.ErrRequired,
+		"tags": valid.Errors{"0": internal},
+	})))
+}
+
+func TestStatus(t *testing.T) {
+	assert.Nil(t, Status(nil))
+
+	st := Status(valid.Errors{"name": valid.ErrRequired})
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		br, ok := st.Details()[0].(*errdetails.BadRequest)
+		if assert.True(t, ok) {
+			assert.Equal(t, [][2]string{{"name", "cannot be blank"}}, pairs(br.GetFieldViolations()))
+		}
+	}
+
+	// the message of an internal error is not sent to clients
+	internal := valid.NewInternalError(errors.New("db down"))
+	for _, err := range []error{internal, valid.Errors{"name": valid.ErrRequired, "tags": valid.Errors{"0": internal}}} {
+		st = Status(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, "internal error", st.Message())
+		assert.Empty(t, st.Details())
+	}
+}
+
+func TestFieldViolations_Struct(t *testing.T) {
+	type address struct {
+		Zip string `json:"zip"`
+	}
+	type user struct {
+		Name    string  `json:"name"`
+		Email   string  `json:"email"`
+		Address address `json:"address"`
+	}
+	u := user{Email: "x"}
+	addressRule := valid.By(func(value interface{}) error {
+		a := value.(address)
+		return valid.ValidateStruct(&a, valid.Field(&a.Zip, valid.Required))
+	})
+	fields := []*valid.FieldRules{
+		valid.Field(&u.Name, valid.Required),
+		valid.Field(&u.Email, valid.Length(3, 0)),
+		valid.Field(&u.Address, addressRule),
+	}
+
+	// the violations follow the order of the errors
+	err := valid.Struct(&u, fields...).InDeclarationOrder().Validate()
+	assert.Equal(t, [][2]string{
+		{"name", "cannot be blank"},
+		{"email", "the length must be no less than 3"},
+		{"address.zip", "cannot be blank"},
+	}, pairs(FieldViolations(err)))
+
+	err = valid.Struct(&u, fields...).AsSlice().Validate()
+	assert.Equal(t, [][2]string{
+		{"name", "cannot be blank"},
+		{"email", "the length must be no less than 3"},
+		{"address.zip", "cannot be blank"},
+	}, pairs(FieldViolations(err)))
+}
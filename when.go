@@ -1,6 +1,9 @@
 package valid
 
-import "context"
+import (
+	"context"
+	"regexp"
+)
 
 // When returns a validation rule that executes the given list of rules when the condition is true.
 func When(condition bool, rules ...Rule) WhenRule {
@@ -11,11 +14,42 @@ func When(condition bool, rules ...Rule) WhenRule {
 	}
 }
 
+// WhenFieldMatches returns a validation rule that executes the given list of rules when the value returned by
+// the getter matches the regular expression. The getter is called every time the rule is validated, so it can read
+// another field of the struct being validated, e.g. to check the state code only for the addresses in the US:
+//
+//	valid.Field(&a.StateCode, valid.WhenFieldMatches(func() interface{} { return a.Country },
+//	    regexp.MustCompile(`^US$`), valid.Required, is.UpperCase,
+//	))
+//
+// The value may be a string, a byte slice, or a pointer to one of them; any other value, including nil,
+// does not match. The returned rule can be combined with ElseWhen and Else like the one returned by When.
+func WhenFieldMatches(getter func() interface{}, re *regexp.Regexp, rules ...Rule) WhenRule {
+	r := When(false, rules...)
+	r.conditionFunc = func() bool {
+		value, isNil := Indirect(getter())
+		if isNil {
+			return false
+		}
+		str, err := EnsureString(value)
+		return err == nil && re.MatchString(str)
+	}
+	return r
+}
+
 // WhenRule is a validation rule that executes the given list of rules when the condition is true.
 type WhenRule struct {
+	condition     bool
+	conditionFunc func() bool
+	rules         []Rule
+	elseWhens     []whenBranch
+	elseRules     []Rule
+}
+
+// whenBranch is a condition added by ElseWhen together with the rules to execute when it is true.
+type whenBranch struct {
 	condition bool
 	rules     []Rule
-	elseRules []Rule
 }
 
 // Validate checks if the condition is true and if so, it validates the value using the specified rules.
@@ -25,20 +59,46 @@ func (r WhenRule) Validate(value interface{}) error {
 
 // ValidateWithContext checks if the condition is true and if so, it validates the value using the specified rules.
 func (r WhenRule) ValidateWithContext(ctx context.Context, value interface{}) error {
-	if r.condition {
-		if ctx == nil {
-			return Validate(value, r.rules...)
+	condition := r.condition
+	if r.conditionFunc != nil {
+		condition = r.conditionFunc()
+	}
+	rules := r.elseRules
+	if condition {
+		rules = r.rules
+	} else {
+		for _, b := range r.elseWhens {
+			if b.condition {
+				rules = b.rules
+				break
+			}
 		}
-		return ValidateWithContext(ctx, value, r.rules...)
 	}
 
 	if ctx == nil {
-		return Validate(value, r.elseRules...)
+		return Validate(value, rules...)
 	}
-	return ValidateWithContext(ctx, value, r.elseRules...)
+	return ValidateWithContext(ctx, value, rules...)
+}
+
+// ElseWhen returns a validation rule that executes the given list of rules when the condition is true
+// and none of the preceding conditions (of When and the previous ElseWhen calls) is true.
+// This allows multi-way conditional validation to be written linearly instead of nesting When rules:
+//
+//	valid.When(kind == "email", is.Email).
+//	    ElseWhen(kind == "phone", is.E164).
+//	    Else(valid.Required)
+//
+// Only the rules of the first true condition are executed. If no condition is true, the rules given to Else are executed.
+func (r WhenRule) ElseWhen(condition bool, rules ...Rule) WhenRule {
+	elseWhens := make([]whenBranch, len(r.elseWhens), len(r.elseWhens)+1)
+	copy(elseWhens, r.elseWhens)
+	r.elseWhens = append(elseWhens, whenBranch{condition: condition, rules: rules})
+	return r
 }
 
 // Else returns a validation rule that executes the given list of rules when the condition is false.
+// If ElseWhen is used, the rules are executed when none of the conditions is true.
 func (r WhenRule) Else(rules ...Rule) WhenRule {
 	r.elseRules = rules
 	return r
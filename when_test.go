@@ -7,8 +7,11 @@ package valid
 import (
 	"context"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func abcValidation(val string) bool {
@@ -57,6 +60,49 @@ func TestWhen(t *testing.T) {
 	}
 }
 
+func TestWhen_ElseWhen(t *testing.T) {
+	abcRule := NewStringRule(abcValidation, "wrong_abc")
+	validateMeRule := NewStringRule(validateMe, "wrong_me")
+	rule := func(a, b, c bool) WhenRule {
+		return When(a, abcRule).
+			ElseWhen(b, validateMeRule).
+			ElseWhen(c, Length(5, 10)).
+			Else(Required)
+	}
+
+	tests := []struct {
+		tag     string
+		a, b, c bool
+		value   interface{}
+		err     string
+	}{
+		{"t1.1", true, false, false, "abc", ""},
+		{"t1.2", true, true, true, "me", "wrong_abc"},
+		{"t2.1", false, true, false, "me", ""},
+		{"t2.2", false, true, true, "abc", "wrong_me"},
+		{"t3.1", false, false, true, "abcdef", ""},
+		{"t3.2", false, false, true, "abc", "the length must be between 5 and 10"},
+		{"t4.1", false, false, false, "abc", ""},
+		{"t4.2", false, false, false, "", "cannot be blank"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, rule(test.a, test.b, test.c))
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// ElseWhen does not modify the rule it is called on
+	base := When(false, abcRule).ElseWhen(false, validateMeRule)
+	r1 := base.ElseWhen(true, Length(5, 10))
+	r2 := base.ElseWhen(true, Required)
+	assertError(t, "the length must be between 5 and 10", Validate("abc", r1), "t5.1")
+	assertError(t, "", Validate("abc", r2), "t5.2")
+	assertError(t, "", Validate("abc", base), "t5.3")
+
+	// without Else, no rules are executed when no condition is true
+	assertError(t, "", Validate("", When(false, Required).ElseWhen(false, Required)), "t6")
+}
+
 type ctxKey int
 
 const (
@@ -98,3 +144,53 @@ func TestWhenWithContext(t *testing.T) {
 		assertError(t, test.err, err, test.tag)
 	}
 }
+
+func TestWhenFieldMatches(t *testing.T) {
+	type address struct {
+		Country   string
+		StateCode string
+	}
+	us := regexp.MustCompile(`^US$`)
+	validate := func(a *address) error {
+		return ValidateStruct(a,
+			Field(&a.StateCode, WhenFieldMatches(func() interface{} { return a.Country }, us, Required, Length(2, 2)).
+				Else(Empty)),
+		)
+	}
+
+	assert.Nil(t, validate(&address{Country: "US", StateCode: "CA"}))
+	assertError(t, "StateCode: cannot be blank.", validate(&address{Country: "US"}), "t1")
+	assertError(t, "StateCode: the length must be exactly 2.", validate(&address{Country: "US", StateCode: "CAL"}), "t2")
+	assert.Nil(t, validate(&address{Country: "DE"}))
+	assertError(t, "StateCode: must be blank.", validate(&address{Country: "DE", StateCode: "BY"}), "t3")
+
+	// the getter is called every time the rule is validated
+	country := "US"
+	rule := WhenFieldMatches(func() interface{} { return country }, us, Required)
+	assertError(t, "cannot be blank", rule.Validate(""), "t4")
+	country = "CA"
+	assert.Nil(t, rule.Validate(""))
+
+	// values other than strings and byte slices never match
+	var nilCountry *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t5.1", &country, "cannot be blank"},
+		{"t5.2", []byte("US"), "cannot be blank"},
+		{"t5.3", nilCountry, ""},
+		{"t5.4", nil, ""},
+		{"t5.5", 1, ""},
+	}
+	country = "US"
+	for _, test := range tests {
+		r := WhenFieldMatches(func() interface{} { return test.value }, us, Required)
+		assertError(t, test.err, r.ValidateWithContext(context.Background(), ""), test.tag)
+	}
+
+	// ElseWhen is checked when the regular expression does not match
+	r := WhenFieldMatches(func() interface{} { return "CA" }, us, Required).ElseWhen(true, Length(5, 0))
+	assertError(t, "the length must be no less than 5", r.Validate("abc"), "t6")
+}